@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/config"
+	"github.com/rodrigosdo/facilities-api/internal/logger"
+	"github.com/rodrigosdo/facilities-api/internal/migration"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const usage = "usage: migrate up|down|status"
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg, err := config.NewDatabase()
+	if err != nil {
+		panic(err)
+	}
+
+	logger, err := logger.New("info")
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+
+	pool, err := pgxpool.New(ctx, cfg.DSN)
+	if err != nil {
+		logger.Fatal("failed to connect to postgres",
+			zap.Any("error", err),
+		)
+	}
+	defer pool.Close()
+
+	migrator, err := migration.New(pool)
+	if err != nil {
+		logger.Fatal("failed to load migrations",
+			zap.Any("error", err),
+		)
+	}
+
+	switch os.Args[1] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			logger.Info("applied migration",
+				zap.Int64("version", m.Version),
+				zap.String("name", m.Name),
+			)
+		}
+
+		if err != nil {
+			logger.Fatal("failed to apply migrations",
+				zap.Any("error", err),
+			)
+		}
+
+		if len(applied) == 0 {
+			logger.Info("no pending migrations")
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			logger.Fatal("failed to revert migration",
+				zap.Any("error", err),
+			)
+		}
+
+		if reverted == nil {
+			logger.Info("no applied migrations")
+			return
+		}
+
+		logger.Info("reverted migration",
+			zap.Int64("version", reverted.Version),
+			zap.String("name", reverted.Name),
+		)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			logger.Fatal("failed to get migrations status",
+				zap.Any("error", err),
+			)
+		}
+
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.UTC().Format(time.RFC3339)
+			}
+
+			fmt.Printf("%04d_%s\t%s\n", s.Migration.Version, s.Migration.Name, appliedAt)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
@@ -3,11 +3,17 @@ package main
 import (
 	"context"
 	"fmt"
+	// Embeds the time zone database, as facilities may be in any time zone.
+	_ "time/tzdata"
 
+	"github.com/rodrigosdo/facilities-api/internal/cache"
 	"github.com/rodrigosdo/facilities-api/internal/config"
 	"github.com/rodrigosdo/facilities-api/internal/logger"
 	"github.com/rodrigosdo/facilities-api/internal/postgres"
 	"github.com/rodrigosdo/facilities-api/internal/server"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/document"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/shift"
 	"github.com/rodrigosdo/facilities-api/internal/usecase/worker"
 
 	"go.uber.org/zap"
@@ -28,21 +34,88 @@ func main() {
 	}
 	defer logger.Sync()
 
-	database, err := postgres.NewDatabase(ctx, cfg.Database)
+	database, err := postgres.NewDatabase(ctx, cfg.Database, cfg.Shift)
 	if err != nil {
 		logger.Fatal("failed to connect to postgres",
 			zap.Any("error", err),
 		)
 	}
 
-	workerAvailableShiftsUseCase := worker.NewAvailableShifts(database)
+	shiftsCache := cache.New(cfg.Cache)
+
+	listener, err := postgres.NewListener(cfg.Database, logger)
+	if err != nil {
+		logger.Fatal("failed to build postgres listener",
+			zap.Any("error", err),
+		)
+	}
+
+	listener.Handle(shiftsCache.HandleChange)
+
+	go func() {
+		if err := listener.Run(ctx); err != nil {
+			logger.Info("postgres listener exited",
+				zap.Any("error", err),
+			)
+		}
+	}()
+
+	workerRepository := cache.NewWorkerRepository(database, shiftsCache)
+	documentRepository := cache.NewDocumentRepository(database, shiftsCache)
+	facilityRepository := cache.NewFacilityRepository(database, shiftsCache)
+
+	workerAvailableShiftsUseCase := worker.NewAvailableShifts(workerRepository)
+	workerClaimShiftUseCase := worker.NewClaimShift(workerRepository)
+	workerCancelShiftUseCase := worker.NewCancelShift(workerRepository, cfg.Shift.CancellationCutoff)
+	workerShiftEligibilityUseCase := worker.NewShiftEligibility(workerRepository)
+	getWorkerUseCase := worker.NewGetWorker(workerRepository)
+	updateWorkerUseCase := worker.NewUpdateWorker(workerRepository)
+	workerDocumentsUseCase := document.NewWorkerDocuments(documentRepository)
+	addWorkerDocumentUseCase := document.NewAddWorkerDocument(documentRepository)
+	removeWorkerDocumentUseCase := document.NewRemoveWorkerDocument(documentRepository)
+	facilityRequirementsUseCase := facility.NewRequirements(facilityRepository)
+	addFacilityRequirementUseCase := facility.NewAddRequirement(facilityRepository)
+	updateFacilityRequirementUseCase := facility.NewUpdateRequirement(facilityRepository)
+	removeFacilityRequirementUseCase := facility.NewRemoveRequirement(facilityRepository)
+	createFacilityShiftUseCase := facility.NewCreateShift(facilityRepository)
+	updateFacilityShiftUseCase := facility.NewUpdateShift(facilityRepository)
+	deleteFacilityShiftUseCase := facility.NewDeleteShift(facilityRepository)
+	shiftTemplatesUseCase := facility.NewShiftTemplates(facilityRepository)
+	createShiftTemplateUseCase := facility.NewCreateShiftTemplate(facilityRepository)
+	materializeShiftTemplateUseCase := facility.NewMaterializeShiftTemplate(facilityRepository)
+	facilityStatusChangesUseCase := facility.NewStatusChanges(facilityRepository)
+	changeFacilityStatusUseCase := facility.NewChangeStatus(facilityRepository)
+	eligibleWorkersUseCase := shift.NewEligibleWorkers(database)
 
 	srv, err := server.New(
 		fmt.Sprintf(":%s", cfg.Server.Port),
 		cfg,
 		logger,
 		database,
-		workerAvailableShiftsUseCase,
+		server.UseCases{
+			AddFacilityRequirement:    addFacilityRequirementUseCase,
+			AddWorkerDocument:         addWorkerDocumentUseCase,
+			ChangeFacilityStatus:      changeFacilityStatusUseCase,
+			CreateFacilityShift:       createFacilityShiftUseCase,
+			CreateShiftTemplate:       createShiftTemplateUseCase,
+			DeleteFacilityShift:       deleteFacilityShiftUseCase,
+			EligibleWorkers:           eligibleWorkersUseCase,
+			FacilityRequirements:      facilityRequirementsUseCase,
+			FacilityStatusChanges:     facilityStatusChangesUseCase,
+			GetWorker:                 getWorkerUseCase,
+			MaterializeShiftTemplate:  materializeShiftTemplateUseCase,
+			RemoveFacilityRequirement: removeFacilityRequirementUseCase,
+			RemoveWorkerDocument:      removeWorkerDocumentUseCase,
+			ShiftTemplates:            shiftTemplatesUseCase,
+			UpdateFacilityRequirement: updateFacilityRequirementUseCase,
+			UpdateFacilityShift:       updateFacilityShiftUseCase,
+			UpdateWorker:              updateWorkerUseCase,
+			WorkerAvailableShifts:     workerAvailableShiftsUseCase,
+			WorkerCancelShift:         workerCancelShiftUseCase,
+			WorkerClaimShift:          workerClaimShiftUseCase,
+			WorkerDocuments:           workerDocumentsUseCase,
+			WorkerShiftEligibility:    workerShiftEligibilityUseCase,
+		},
 	)
 	if err != nil {
 		logger.Fatal("failed to build server",
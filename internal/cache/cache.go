@@ -0,0 +1,201 @@
+// Package cache keeps pages of available shifts in memory, invalidating them
+// as the changes that may alter them are made through the repositories it
+// decorates or notified by the database.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/config"
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"cloud.google.com/go/civil"
+)
+
+// Cache holds pages of available shifts, evicting the least recently used
+// ones beyond its size and expiring them after its TTL, which bounds how long
+// they are served stale when changes made elsewhere go unnoticed.
+type Cache struct {
+	mu sync.Mutex
+	// generation is bumped by every invalidation, so pages loaded while one
+	// happens are not stored.
+	generation uint64
+	entries    map[string]*list.Element
+	// order holds the entries, most recently used first.
+	order    *list.List
+	byWorker map[int64]map[string]struct{}
+	now      func() time.Time
+	size     int
+	ttl      time.Duration
+}
+
+type entry struct {
+	expiresAt time.Time
+	key       string
+	shifts    domain.Shifts
+	workerID  int64
+}
+
+func New(cfg config.Cache) *Cache {
+	return &Cache{
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+		byWorker: map[int64]map[string]struct{}{},
+		now:      time.Now,
+		size:     cfg.Size,
+		ttl:      cfg.TTL,
+	}
+}
+
+// Len returns how many pages are held, including expired ones not evicted yet.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// InvalidateWorker drops the pages of the worker, for changes only affecting
+// which shifts are available to them.
+func (c *Cache) InvalidateWorker(workerID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	for key := range c.byWorker[workerID] {
+		c.remove(c.entries[key])
+	}
+}
+
+// InvalidateAll drops every page, for changes affecting which shifts are
+// available to any worker.
+func (c *Cache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	c.entries = map[string]*list.Element{}
+	c.order.Init()
+	c.byWorker = map[int64]map[string]struct{}{}
+}
+
+// HandleChange invalidates the pages a change notified by the database may
+// affect, including the ones made by other instances.
+func (c *Cache) HandleChange(_ context.Context, event domain.ChangeEvent) {
+	switch e := event.(type) {
+	case domain.WorkerChanged:
+		c.InvalidateWorker(e.WorkerID)
+	case domain.WorkerDocumentChanged:
+		c.InvalidateWorker(e.WorkerID)
+	default:
+		c.InvalidateAll()
+	}
+}
+
+// get returns a copy of the page stored under the key, if any and not expired,
+// along with the generation to store it with otherwise.
+func (c *Cache) get(key string) (domain.Shifts, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, c.generation, false
+	}
+
+	e := element.Value.(*entry)
+	if !c.now().Before(e.expiresAt) {
+		c.remove(element)
+		return nil, c.generation, false
+	}
+
+	c.order.MoveToFront(element)
+
+	return append(domain.Shifts{}, e.shifts...), c.generation, true
+}
+
+// set stores a copy of the page of the worker under the key, unless an
+// invalidation happened since the given generation was returned by get.
+func (c *Cache) set(key string, workerID int64, shifts domain.Shifts, generation uint64) {
+	if c.size <= 0 || c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+
+	c.entries[key] = c.order.PushFront(&entry{
+		expiresAt: c.now().Add(c.ttl),
+		key:       key,
+		shifts:    append(domain.Shifts{}, shifts...),
+		workerID:  workerID,
+	})
+
+	if c.byWorker[workerID] == nil {
+		c.byWorker[workerID] = map[string]struct{}{}
+	}
+	c.byWorker[workerID][key] = struct{}{}
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *Cache) remove(element *list.Element) {
+	e := c.order.Remove(element).(*entry)
+
+	delete(c.entries, e.key)
+
+	delete(c.byWorker[e.workerID], e.key)
+	if len(c.byWorker[e.workerID]) == 0 {
+		delete(c.byWorker, e.workerID)
+	}
+}
+
+// pageKey identifies a page of available shifts by every argument of
+// domain.WorkerRepository.GetAvailableShifts.
+func pageKey(queryCursor *cursor.Cursor, limit int, workerID int64, filter domain.ShiftFilter, sort domain.ShiftSort) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d|%d|%s|", workerID, limit, sort)
+
+	if queryCursor != nil {
+		fmt.Fprintf(&b, "%s%q", queryCursor.Direction, queryCursor.Keys)
+	}
+
+	fmt.Fprintf(&b, "|%s|%s|%v|%s|%s|%d|%d",
+		filter.Start,
+		filter.End,
+		filter.FacilityIDs,
+		timeOfDayKey(filter.StartTimeFrom),
+		timeOfDayKey(filter.StartTimeTo),
+		filter.MinDuration,
+		filter.MaxDuration,
+	)
+
+	return b.String()
+}
+
+func timeOfDayKey(t *civil.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.String()
+}
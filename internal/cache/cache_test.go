@@ -0,0 +1,236 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/cache"
+	"github.com/rodrigosdo/facilities-api/internal/config"
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"cloud.google.com/go/civil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeWorkerID := int64(123)
+	fakeShifts := domain.Shifts{{ID: 1}, {ID: 2}}
+
+	t.Run("should serve a page from the cache once loaded", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, 10, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart).
+			Times(1).
+			Return(fakeShifts, nil)
+
+		wr := cache.NewWorkerRepository(mockWorkerRepository, cache.New(config.Cache{Size: 10, TTL: time.Minute}))
+
+		for i := 0; i < 2; i++ {
+			shifts, err := wr.GetAvailableShifts(ctx, nil, 10, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart)
+			assert.NoError(t, err)
+			assert.Equal(t, fakeShifts, shifts)
+		}
+	})
+
+	t.Run("should load pages differing in any argument separately", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		from := civil.Time{Hour: 8}
+		date := civil.Date{Year: 2023, Month: time.February, Day: 2}
+		afterCursor := cursor.New(cursor.DirectionAfter, "2023-02-15T05:00:00Z", "21")
+		beforeCursor := cursor.New(cursor.DirectionBefore, "2023-02-15T05:00:00Z", "21")
+
+		pages := []struct {
+			filter      domain.ShiftFilter
+			limit       int
+			queryCursor *cursor.Cursor
+			sort        domain.ShiftSort
+			workerID    int64
+		}{
+			{limit: 10, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+			{limit: 10, sort: domain.ShiftSortStart, workerID: fakeWorkerID + 1},
+			{limit: 20, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+			{limit: 10, sort: domain.ShiftSortEnd, workerID: fakeWorkerID},
+			{limit: 10, queryCursor: afterCursor, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+			{limit: 10, queryCursor: beforeCursor, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+			{filter: domain.ShiftFilter{End: date, Start: date}, limit: 10, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+			{filter: domain.ShiftFilter{FacilityIDs: []int64{1}}, limit: 10, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+			{filter: domain.ShiftFilter{StartTimeFrom: &from}, limit: 10, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+			{filter: domain.ShiftFilter{StartTimeTo: &from}, limit: 10, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+			{filter: domain.ShiftFilter{MinDuration: time.Hour}, limit: 10, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+			{filter: domain.ShiftFilter{MaxDuration: time.Hour}, limit: 10, sort: domain.ShiftSortStart, workerID: fakeWorkerID},
+		}
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(len(pages)).
+			Return(fakeShifts, nil)
+
+		c := cache.New(config.Cache{Size: 100, TTL: time.Minute})
+		wr := cache.NewWorkerRepository(mockWorkerRepository, c)
+
+		for _, p := range pages {
+			_, err := wr.GetAvailableShifts(ctx, p.queryCursor, p.limit, p.workerID, p.filter, p.sort)
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, len(pages), c.Len())
+	})
+
+	t.Run("should load a page again once expired", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, 10, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart).
+			Times(2).
+			Return(fakeShifts, nil)
+
+		loadedAt := time.Date(2023, time.February, 2, 10, 0, 0, 0, time.UTC)
+		now := loadedAt
+
+		c := cache.New(config.Cache{Size: 10, TTL: time.Minute})
+		c.SetNow(func() time.Time { return now })
+		wr := cache.NewWorkerRepository(mockWorkerRepository, c)
+
+		for _, elapsed := range []time.Duration{0, 59 * time.Second, time.Minute} {
+			now = loadedAt.Add(elapsed)
+
+			_, err := wr.GetAvailableShifts(ctx, nil, 10, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("should evict the least recently used page beyond its size", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		for _, limit := range []int{1, 2, 3} {
+			mockWorkerRepository.
+				EXPECT().
+				GetAvailableShifts(ctx, nil, limit, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart).
+				Times(1).
+				Return(fakeShifts, nil)
+		}
+		// Evicted by the third page, as the first one was used since.
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, 2, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart).
+			Times(1).
+			Return(fakeShifts, nil)
+
+		c := cache.New(config.Cache{Size: 2, TTL: time.Minute})
+		wr := cache.NewWorkerRepository(mockWorkerRepository, c)
+
+		for _, limit := range []int{1, 2, 1, 3, 1, 2} {
+			_, err := wr.GetAvailableShifts(ctx, nil, limit, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart)
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, 2, c.Len())
+	})
+
+	t.Run("should not store pages when disabled", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, 10, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart).
+			Times(2).
+			Return(fakeShifts, nil)
+
+		c := cache.New(config.Cache{Size: 0, TTL: time.Minute})
+		wr := cache.NewWorkerRepository(mockWorkerRepository, c)
+
+		for i := 0; i < 2; i++ {
+			_, err := wr.GetAvailableShifts(ctx, nil, 10, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart)
+			assert.NoError(t, err)
+		}
+
+		assert.Zero(t, c.Len())
+	})
+
+	t.Run("should not store a page loaded while invalidating", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := cache.New(config.Cache{Size: 10, TTL: time.Minute})
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, 10, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart).
+			Times(1).
+			DoAndReturn(func(context.Context, *cursor.Cursor, int, int64, domain.ShiftFilter, domain.ShiftSort) (domain.Shifts, error) {
+				c.InvalidateWorker(fakeWorkerID)
+				return fakeShifts, nil
+			})
+
+		wr := cache.NewWorkerRepository(mockWorkerRepository, c)
+
+		_, err := wr.GetAvailableShifts(ctx, nil, 10, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart)
+		assert.NoError(t, err)
+		assert.Zero(t, c.Len())
+	})
+
+	t.Run("should drop only the pages of the invalidated worker", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, gomock.Any(), gomock.Any(), domain.ShiftFilter{}, domain.ShiftSortStart).
+			Times(3).
+			Return(fakeShifts, nil)
+
+		c := cache.New(config.Cache{Size: 10, TTL: time.Minute})
+		wr := cache.NewWorkerRepository(mockWorkerRepository, c)
+
+		for _, limit := range []int{10, 20} {
+			_, err := wr.GetAvailableShifts(ctx, nil, limit, fakeWorkerID, domain.ShiftFilter{}, domain.ShiftSortStart)
+			assert.NoError(t, err)
+		}
+
+		_, err := wr.GetAvailableShifts(ctx, nil, 10, fakeWorkerID+1, domain.ShiftFilter{}, domain.ShiftSortStart)
+		assert.NoError(t, err)
+
+		c.InvalidateWorker(fakeWorkerID)
+		assert.Equal(t, 1, c.Len())
+
+		c.InvalidateAll()
+		assert.Zero(t, c.Len())
+	})
+}
@@ -0,0 +1,8 @@
+package cache
+
+import "time"
+
+// SetNow replaces the clock pages expire by.
+func (c *Cache) SetNow(now func() time.Time) {
+	c.now = now
+}
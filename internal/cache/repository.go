@@ -0,0 +1,230 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"cloud.google.com/go/civil"
+)
+
+type workerRepository struct {
+	domain.WorkerRepository
+	cache *Cache
+}
+
+// NewWorkerRepository serves available shifts from the cache, invalidating it
+// as shifts are claimed or cancelled and workers change.
+func NewWorkerRepository(wr domain.WorkerRepository, cache *Cache) domain.WorkerRepository {
+	return &workerRepository{
+		WorkerRepository: wr,
+		cache:            cache,
+	}
+}
+
+func (r *workerRepository) GetAvailableShifts(ctx context.Context, queryCursor *cursor.Cursor, limit int, workerID int64, filter domain.ShiftFilter, sort domain.ShiftSort) (domain.Shifts, error) {
+	key := pageKey(queryCursor, limit, workerID, filter, sort)
+
+	shifts, generation, ok := r.cache.get(key)
+	if ok {
+		return shifts, nil
+	}
+
+	shifts, err := r.WorkerRepository.GetAvailableShifts(ctx, queryCursor, limit, workerID, filter, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.set(key, workerID, shifts, generation)
+
+	return shifts, nil
+}
+
+// CancelShift invalidates every page, as the shift becomes available to
+// other workers.
+func (r *workerRepository) CancelShift(ctx context.Context, workerID int64, shiftID int64, startsAfter time.Time) (*domain.Shift, error) {
+	shift, err := r.WorkerRepository.CancelShift(ctx, workerID, shiftID, startsAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.InvalidateAll()
+
+	return shift, nil
+}
+
+// ClaimShift invalidates every page, as the shift is no longer available to
+// any worker.
+func (r *workerRepository) ClaimShift(ctx context.Context, workerID int64, shiftID int64) (*domain.Shift, error) {
+	shift, err := r.WorkerRepository.ClaimShift(ctx, workerID, shiftID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.InvalidateAll()
+
+	return shift, nil
+}
+
+// UpdateWorker invalidates the pages of the worker, or every page when shifts
+// they claimed were released.
+func (r *workerRepository) UpdateWorker(ctx context.Context, workerID int64, update domain.WorkerUpdate, releaseAfter time.Time) (*domain.WorkerChange, error) {
+	change, err := r.WorkerRepository.UpdateWorker(ctx, workerID, update, releaseAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(change.ReleasedShifts) > 0 {
+		r.cache.InvalidateAll()
+	} else {
+		r.cache.InvalidateWorker(workerID)
+	}
+
+	return change, nil
+}
+
+type facilityRepository struct {
+	domain.FacilityRepository
+	cache *Cache
+}
+
+// NewFacilityRepository invalidates every page of the cache as facilities,
+// their requirements and their shifts change, as any worker may see them.
+// Dry runs change nothing, so they invalidate nothing.
+func NewFacilityRepository(fr domain.FacilityRepository, cache *Cache) domain.FacilityRepository {
+	return &facilityRepository{
+		FacilityRepository: fr,
+		cache:              cache,
+	}
+}
+
+func (r *facilityRepository) AddFacilityRequirement(ctx context.Context, facilityID int64, documentID int64, profession *domain.Profession, openAfter time.Time, dryRun bool) (*domain.RequirementChange, error) {
+	change, err := r.FacilityRepository.AddFacilityRequirement(ctx, facilityID, documentID, profession, openAfter, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun {
+		r.cache.InvalidateAll()
+	}
+
+	return change, nil
+}
+
+func (r *facilityRepository) ChangeFacilityStatus(ctx context.Context, facilityID int64, action domain.FacilityAction, suspendedUntil *time.Time, cancelClaims bool, after time.Time) (*domain.FacilityLifecycleChange, error) {
+	change, err := r.FacilityRepository.ChangeFacilityStatus(ctx, facilityID, action, suspendedUntil, cancelClaims, after)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.InvalidateAll()
+
+	return change, nil
+}
+
+func (r *facilityRepository) CreateShift(ctx context.Context, facilityID int64, start time.Time, end time.Time, profession domain.Profession) (*domain.FacilityShift, error) {
+	shift, err := r.FacilityRepository.CreateShift(ctx, facilityID, start, end, profession)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.InvalidateAll()
+
+	return shift, nil
+}
+
+func (r *facilityRepository) DeleteShift(ctx context.Context, facilityID int64, shiftID int64, unassign bool) (*domain.FacilityShift, error) {
+	shift, err := r.FacilityRepository.DeleteShift(ctx, facilityID, shiftID, unassign)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.InvalidateAll()
+
+	return shift, nil
+}
+
+func (r *facilityRepository) MaterializeShiftTemplate(ctx context.Context, facilityID int64, templateID int64, start civil.Date, end civil.Date, after time.Time) (*domain.ShiftMaterialization, error) {
+	materialization, err := r.FacilityRepository.MaterializeShiftTemplate(ctx, facilityID, templateID, start, end, after)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.InvalidateAll()
+
+	return materialization, nil
+}
+
+func (r *facilityRepository) RemoveFacilityRequirement(ctx context.Context, facilityID int64, requirementID int64, openAfter time.Time, dryRun bool) (*domain.RequirementChange, error) {
+	change, err := r.FacilityRepository.RemoveFacilityRequirement(ctx, facilityID, requirementID, openAfter, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun {
+		r.cache.InvalidateAll()
+	}
+
+	return change, nil
+}
+
+func (r *facilityRepository) UpdateFacilityRequirement(ctx context.Context, facilityID int64, requirementID int64, documentID int64, profession *domain.Profession, openAfter time.Time, dryRun bool) (*domain.RequirementChange, error) {
+	change, err := r.FacilityRepository.UpdateFacilityRequirement(ctx, facilityID, requirementID, documentID, profession, openAfter, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun {
+		r.cache.InvalidateAll()
+	}
+
+	return change, nil
+}
+
+func (r *facilityRepository) UpdateShift(ctx context.Context, facilityID int64, shiftID int64, update domain.ShiftUpdate, unassign bool) (*domain.FacilityShift, error) {
+	shift, err := r.FacilityRepository.UpdateShift(ctx, facilityID, shiftID, update, unassign)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.InvalidateAll()
+
+	return shift, nil
+}
+
+type documentRepository struct {
+	domain.DocumentRepository
+	cache *Cache
+}
+
+// NewDocumentRepository invalidates the pages of a worker in the cache as
+// their documents change.
+func NewDocumentRepository(dr domain.DocumentRepository, cache *Cache) domain.DocumentRepository {
+	return &documentRepository{
+		DocumentRepository: dr,
+		cache:              cache,
+	}
+}
+
+func (r *documentRepository) AddWorkerDocument(ctx context.Context, workerID int64, documentID int64, expiresAt *time.Time) (*domain.WorkerDocument, error) {
+	document, err := r.DocumentRepository.AddWorkerDocument(ctx, workerID, documentID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.InvalidateWorker(workerID)
+
+	return document, nil
+}
+
+func (r *documentRepository) RemoveWorkerDocument(ctx context.Context, workerID int64, documentID int64) error {
+	if err := r.DocumentRepository.RemoveWorkerDocument(ctx, workerID, documentID); err != nil {
+		return err
+	}
+
+	r.cache.InvalidateWorker(workerID)
+
+	return nil
+}
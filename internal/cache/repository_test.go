@@ -0,0 +1,252 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/cache"
+	"github.com/rodrigosdo/facilities-api/internal/config"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"cloud.google.com/go/civil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeWorkerID := int64(1)
+	otherWorkerID := int64(2)
+	fakeFacilityID := int64(10)
+	fakeShiftID := int64(100)
+	now := time.Now()
+	date := civil.DateOf(now)
+	fakeProfession := domain.Profession("CNA")
+
+	tests := map[string]struct {
+		change    func(ctrl *gomock.Controller, c *cache.Cache) error
+		remaining int
+	}{
+		"when a shift is claimed, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+				mockWorkerRepository.EXPECT().ClaimShift(ctx, fakeWorkerID, fakeShiftID).Return(&domain.Shift{}, nil)
+
+				_, err := cache.NewWorkerRepository(mockWorkerRepository, c).ClaimShift(ctx, fakeWorkerID, fakeShiftID)
+				return err
+			},
+			remaining: 0,
+		},
+		"when claiming a shift fails, should keep every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+				mockWorkerRepository.EXPECT().ClaimShift(ctx, fakeWorkerID, fakeShiftID).Return(nil, domain.ErrShiftAlreadyClaimed)
+
+				_, err := cache.NewWorkerRepository(mockWorkerRepository, c).ClaimShift(ctx, fakeWorkerID, fakeShiftID)
+				if !errors.Is(err, domain.ErrShiftAlreadyClaimed) {
+					return err
+				}
+
+				return nil
+			},
+			remaining: 2,
+		},
+		"when a shift is cancelled, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+				mockWorkerRepository.EXPECT().CancelShift(ctx, fakeWorkerID, fakeShiftID, now).Return(&domain.Shift{}, nil)
+
+				_, err := cache.NewWorkerRepository(mockWorkerRepository, c).CancelShift(ctx, fakeWorkerID, fakeShiftID, now)
+				return err
+			},
+			remaining: 0,
+		},
+		"when a worker is updated, should drop their pages": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+				mockWorkerRepository.EXPECT().UpdateWorker(ctx, fakeWorkerID, domain.WorkerUpdate{}, now).Return(&domain.WorkerChange{}, nil)
+
+				_, err := cache.NewWorkerRepository(mockWorkerRepository, c).UpdateWorker(ctx, fakeWorkerID, domain.WorkerUpdate{}, now)
+				return err
+			},
+			remaining: 1,
+		},
+		"when a worker is updated releasing their shifts, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+				mockWorkerRepository.EXPECT().UpdateWorker(ctx, fakeWorkerID, domain.WorkerUpdate{}, now).Return(&domain.WorkerChange{ReleasedShifts: []domain.Shift{{ID: fakeShiftID}}}, nil)
+
+				_, err := cache.NewWorkerRepository(mockWorkerRepository, c).UpdateWorker(ctx, fakeWorkerID, domain.WorkerUpdate{}, now)
+				return err
+			},
+			remaining: 0,
+		},
+		"when a document is added to a worker, should drop their pages": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockDocumentRepository := domain.NewMockDocumentRepository(ctrl)
+				mockDocumentRepository.EXPECT().AddWorkerDocument(ctx, fakeWorkerID, int64(5), nil).Return(&domain.WorkerDocument{}, nil)
+
+				_, err := cache.NewDocumentRepository(mockDocumentRepository, c).AddWorkerDocument(ctx, fakeWorkerID, 5, nil)
+				return err
+			},
+			remaining: 1,
+		},
+		"when a document is removed from a worker, should drop their pages": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockDocumentRepository := domain.NewMockDocumentRepository(ctrl)
+				mockDocumentRepository.EXPECT().RemoveWorkerDocument(ctx, fakeWorkerID, int64(5)).Return(nil)
+
+				return cache.NewDocumentRepository(mockDocumentRepository, c).RemoveWorkerDocument(ctx, fakeWorkerID, 5)
+			},
+			remaining: 1,
+		},
+		"when a facility requirement is added, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+				mockFacilityRepository.EXPECT().AddFacilityRequirement(ctx, fakeFacilityID, int64(5), nil, now, false).Return(&domain.RequirementChange{}, nil)
+
+				_, err := cache.NewFacilityRepository(mockFacilityRepository, c).AddFacilityRequirement(ctx, fakeFacilityID, 5, nil, now, false)
+				return err
+			},
+			remaining: 0,
+		},
+		"when a facility requirement change is a dry run, should keep every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+				mockFacilityRepository.EXPECT().RemoveFacilityRequirement(ctx, fakeFacilityID, int64(7), now, true).Return(&domain.RequirementChange{}, nil)
+
+				_, err := cache.NewFacilityRepository(mockFacilityRepository, c).RemoveFacilityRequirement(ctx, fakeFacilityID, 7, now, true)
+				return err
+			},
+			remaining: 2,
+		},
+		"when a facility requirement is updated, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+				mockFacilityRepository.EXPECT().UpdateFacilityRequirement(ctx, fakeFacilityID, int64(7), int64(5), nil, now, false).Return(&domain.RequirementChange{}, nil)
+
+				_, err := cache.NewFacilityRepository(mockFacilityRepository, c).UpdateFacilityRequirement(ctx, fakeFacilityID, 7, 5, nil, now, false)
+				return err
+			},
+			remaining: 0,
+		},
+		"when a facility status changes, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+				mockFacilityRepository.EXPECT().ChangeFacilityStatus(ctx, fakeFacilityID, domain.FacilityActionDeactivate, nil, false, now).Return(&domain.FacilityLifecycleChange{}, nil)
+
+				_, err := cache.NewFacilityRepository(mockFacilityRepository, c).ChangeFacilityStatus(ctx, fakeFacilityID, domain.FacilityActionDeactivate, nil, false, now)
+				return err
+			},
+			remaining: 0,
+		},
+		"when a facility shift is created, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+				mockFacilityRepository.EXPECT().CreateShift(ctx, fakeFacilityID, now, now, fakeProfession).Return(&domain.FacilityShift{}, nil)
+
+				_, err := cache.NewFacilityRepository(mockFacilityRepository, c).CreateShift(ctx, fakeFacilityID, now, now, fakeProfession)
+				return err
+			},
+			remaining: 0,
+		},
+		"when a facility shift is updated, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+				mockFacilityRepository.EXPECT().UpdateShift(ctx, fakeFacilityID, fakeShiftID, domain.ShiftUpdate{}, false).Return(&domain.FacilityShift{}, nil)
+
+				_, err := cache.NewFacilityRepository(mockFacilityRepository, c).UpdateShift(ctx, fakeFacilityID, fakeShiftID, domain.ShiftUpdate{}, false)
+				return err
+			},
+			remaining: 0,
+		},
+		"when a facility shift is deleted, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+				mockFacilityRepository.EXPECT().DeleteShift(ctx, fakeFacilityID, fakeShiftID, false).Return(&domain.FacilityShift{}, nil)
+
+				_, err := cache.NewFacilityRepository(mockFacilityRepository, c).DeleteShift(ctx, fakeFacilityID, fakeShiftID, false)
+				return err
+			},
+			remaining: 0,
+		},
+		"when a shift template is materialized, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+				mockFacilityRepository.EXPECT().MaterializeShiftTemplate(ctx, fakeFacilityID, int64(3), date, date, now).Return(&domain.ShiftMaterialization{}, nil)
+
+				_, err := cache.NewFacilityRepository(mockFacilityRepository, c).MaterializeShiftTemplate(ctx, fakeFacilityID, 3, date, date, now)
+				return err
+			},
+			remaining: 0,
+		},
+		"when a worker change is notified, should drop their pages": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				c.HandleChange(ctx, domain.WorkerChanged{Operation: domain.ChangeOperationUpdate, WorkerID: fakeWorkerID})
+				return nil
+			},
+			remaining: 1,
+		},
+		"when a worker document change is notified, should drop their pages": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				c.HandleChange(ctx, domain.WorkerDocumentChanged{DocumentID: 5, Operation: domain.ChangeOperationInsert, WorkerID: fakeWorkerID})
+				return nil
+			},
+			remaining: 1,
+		},
+		"when a shift change is notified, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				c.HandleChange(ctx, domain.ShiftChanged{FacilityID: fakeFacilityID, Operation: domain.ChangeOperationUpdate, ShiftID: fakeShiftID})
+				return nil
+			},
+			remaining: 0,
+		},
+		"when a document change is notified, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				c.HandleChange(ctx, domain.DocumentChanged{DocumentID: 5, Operation: domain.ChangeOperationUpdate})
+				return nil
+			},
+			remaining: 0,
+		},
+		"when changes may have been missed, should drop every page": {
+			change: func(ctrl *gomock.Controller, c *cache.Cache) error {
+				c.HandleChange(ctx, domain.ChangesMissed{})
+				return nil
+			},
+			remaining: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+			mockWorkerRepository.
+				EXPECT().
+				GetAvailableShifts(ctx, nil, 10, gomock.Any(), domain.ShiftFilter{}, domain.ShiftSortStart).
+				Times(2).
+				Return(domain.Shifts{}, nil)
+
+			c := cache.New(config.Cache{Size: 10, TTL: time.Minute})
+			wr := cache.NewWorkerRepository(mockWorkerRepository, c)
+
+			for _, workerID := range []int64{fakeWorkerID, otherWorkerID} {
+				_, err := wr.GetAvailableShifts(ctx, nil, 10, workerID, domain.ShiftFilter{}, domain.ShiftSortStart)
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, tt.change(ctrl, c))
+			assert.Equal(t, tt.remaining, c.Len())
+		})
+	}
+}
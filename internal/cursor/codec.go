@@ -0,0 +1,123 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// version is the version of the encoding produced by Codec.Encode. Cursors
+// encoded with any other version are rejected.
+const version = "v1"
+
+var (
+	ErrExpired            = errors.New("cursor has expired")
+	ErrMalformed          = errors.New("cursor is malformed")
+	ErrSignatureMismatch  = errors.New("cursor was not issued for this query")
+	ErrUnsupportedVersion = errors.New("cursor version is not supported")
+)
+
+// Codec encodes cursors into opaque tokens signed with HMAC-SHA256 and decodes
+// them back. A token is only valid for the scope it was encoded with, which
+// should identify the query that produced it, and until its TTL elapses.
+type Codec struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+type payload struct {
+	Direction Direction `json:"d"`
+	ExpiresAt int64     `json:"e"`
+	Keys      []string  `json:"k"`
+}
+
+func NewCodec(secret []byte, ttl time.Duration) (*Codec, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("a secret is required to sign cursors")
+	}
+
+	if ttl <= 0 {
+		return nil, errors.New("cursor ttl must be positive")
+	}
+
+	return &Codec{
+		secret: secret,
+		ttl:    ttl,
+	}, nil
+}
+
+// Encode returns the token of the given cursor, bound to the given scope.
+func (c *Codec) Encode(cursor *Cursor, scope string) (string, error) {
+	data, err := json.Marshal(payload{
+		Direction: cursor.Direction,
+		ExpiresAt: time.Now().Add(c.ttl).UnixMilli(),
+		Keys:      cursor.Keys,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	encoded := version + "." + base64.RawURLEncoding.EncodeToString(data)
+
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(c.sign(encoded, scope)), nil
+}
+
+// Decode returns the cursor of the given token as long as it was encoded for
+// the given scope and has not expired. An empty token decodes to no cursor.
+func (c *Codec) Decode(token string, scope string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+
+	if parts[0] != version {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedVersion, parts[0])
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	if !hmac.Equal(signature, c.sign(parts[0]+"."+parts[1], scope)) {
+		return nil, ErrSignatureMismatch
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	var p payload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, ErrMalformed
+	}
+
+	if p.Direction != DirectionAfter && p.Direction != DirectionBefore {
+		return nil, ErrMalformed
+	}
+
+	if time.Now().After(time.UnixMilli(p.ExpiresAt)) {
+		return nil, ErrExpired
+	}
+
+	return New(p.Direction, p.Keys...), nil
+}
+
+func (c *Codec) sign(encoded string, scope string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(encoded))
+	mac.Write([]byte{0})
+	mac.Write([]byte(scope))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,126 @@
+package cursor_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+
+	codec, err := cursor.NewCodec([]byte("secret"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fakeCursor := cursor.New(cursor.DirectionBefore, "Facility A, B", "2023-02-15T05:00:00Z", "21")
+
+	t.Run("should return error when no secret is given", func(t *testing.T) {
+		t.Parallel()
+
+		codec, err := cursor.NewCodec(nil, time.Hour)
+		assert.Error(t, err)
+		assert.Nil(t, codec)
+	})
+
+	t.Run("should decode an encoded cursor back", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := codec.Encode(fakeCursor, "scope")
+		assert.NoError(t, err)
+
+		decoded, err := codec.Decode(token, "scope")
+		assert.NoError(t, err)
+		assert.Equal(t, fakeCursor, decoded)
+	})
+
+	t.Run("should decode no cursor when given an empty token", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := codec.Decode("", "scope")
+		assert.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("should return error when given another scope", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := codec.Encode(fakeCursor, "scope")
+		assert.NoError(t, err)
+
+		decoded, err := codec.Decode(token, "another scope")
+		assert.ErrorIs(t, err, cursor.ErrSignatureMismatch)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("should return error when signed with another secret", func(t *testing.T) {
+		t.Parallel()
+
+		anotherCodec, err := cursor.NewCodec([]byte("another secret"), time.Hour)
+		assert.NoError(t, err)
+
+		token, err := anotherCodec.Encode(fakeCursor, "scope")
+		assert.NoError(t, err)
+
+		decoded, err := codec.Decode(token, "scope")
+		assert.ErrorIs(t, err, cursor.ErrSignatureMismatch)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("should return error when the payload is tampered with", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := codec.Encode(fakeCursor, "scope")
+		assert.NoError(t, err)
+
+		parts := strings.Split(token, ".")
+		parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"d":"after","e":99999999999999,"k":["1"]}`))
+
+		decoded, err := codec.Decode(strings.Join(parts, "."), "scope")
+		assert.ErrorIs(t, err, cursor.ErrSignatureMismatch)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("should return error when expired", func(t *testing.T) {
+		t.Parallel()
+
+		shortLivedCodec, err := cursor.NewCodec([]byte("secret"), time.Millisecond)
+		assert.NoError(t, err)
+
+		token, err := shortLivedCodec.Encode(fakeCursor, "scope")
+		assert.NoError(t, err)
+
+		time.Sleep(5 * time.Millisecond)
+
+		decoded, err := shortLivedCodec.Decode(token, "scope")
+		assert.ErrorIs(t, err, cursor.ErrExpired)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("should return error when given an unsupported version", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := codec.Encode(fakeCursor, "scope")
+		assert.NoError(t, err)
+
+		decoded, err := codec.Decode("v0"+strings.TrimPrefix(token, "v1"), "scope")
+		assert.ErrorIs(t, err, cursor.ErrUnsupportedVersion)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("should return error when malformed", func(t *testing.T) {
+		t.Parallel()
+
+		for _, token := range []string{"invalid", "YWZ0ZXJfMjE=", "v1.!.!"} {
+			decoded, err := codec.Decode(token, "scope")
+			assert.ErrorIs(t, err, cursor.ErrMalformed, token)
+			assert.Nil(t, decoded)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package cursor
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 200
+)
+
+// Page holds rows in ascending order. The cursors are nil when there are no
+// pages in their direction.
+type Page[T any] struct {
+	NextCursor *Cursor
+	PrevCursor *Cursor
+	Rows       []T
+}
+
+// Paginate fetches the page of rows from the given cursor, if any, of at most
+// limit rows, or DefaultLimit when the limit is not between 1 and MaxLimit.
+// fetch must return the rows in ascending order, and keys the values of the
+// keys they are sorted by, which the cursors of the page point at.
+func Paginate[T any](from *Cursor, limit int, fetch func(limit int) ([]T, error), keys func(row T) []string) (*Page[T], error) {
+	if limit <= 0 || limit > MaxLimit {
+		limit = DefaultLimit
+	}
+
+	// One more row than requested is fetched to tell whether there is a page
+	// beyond this one.
+	rows, err := fetch(limit + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return &Page[T]{}, nil
+	}
+
+	backwards := from != nil && from.Direction == DirectionBefore
+	hasMore := len(rows) > limit
+
+	if hasMore {
+		// The extra row is the farthest from the cursor: the first when going
+		// backwards, the last otherwise.
+		if backwards {
+			rows = rows[1:]
+		} else {
+			rows = rows[:limit]
+		}
+	}
+
+	page := &Page[T]{
+		Rows: rows,
+	}
+
+	nextCursor := New(DirectionAfter, keys(rows[len(rows)-1])...)
+	prevCursor := New(DirectionBefore, keys(rows[0])...)
+
+	if backwards {
+		// Going backwards means coming from a later page.
+		page.NextCursor = nextCursor
+		if hasMore {
+			page.PrevCursor = prevCursor
+		}
+	} else {
+		if hasMore {
+			page.NextCursor = nextCursor
+		}
+		if from != nil {
+			page.PrevCursor = prevCursor
+		}
+	}
+
+	return page, nil
+}
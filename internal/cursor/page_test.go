@@ -0,0 +1,112 @@
+package cursor_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	keys := func(row int) []string {
+		return []string{strconv.Itoa(row)}
+	}
+
+	fetchRows := func(rows ...int) func(limit int) ([]int, error) {
+		return func(limit int) ([]int, error) {
+			return rows, nil
+		}
+	}
+
+	t.Run("should fetch one more row than the default limit when none is given", func(t *testing.T) {
+		t.Parallel()
+
+		var fetched int
+		_, err := cursor.Paginate(nil, 0, func(limit int) ([]int, error) {
+			fetched = limit
+			return nil, nil
+		}, keys)
+		assert.NoError(t, err)
+		assert.Equal(t, cursor.DefaultLimit+1, fetched)
+	})
+
+	t.Run("should fetch one more row than the default limit when the limit is too high", func(t *testing.T) {
+		t.Parallel()
+
+		var fetched int
+		_, err := cursor.Paginate(nil, cursor.MaxLimit+1, func(limit int) ([]int, error) {
+			fetched = limit
+			return nil, nil
+		}, keys)
+		assert.NoError(t, err)
+		assert.Equal(t, cursor.DefaultLimit+1, fetched)
+	})
+
+	t.Run("should return error when failing to fetch the rows", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := cursor.Paginate(nil, 2, func(limit int) ([]int, error) {
+			return nil, errors.New("failed")
+		}, keys)
+		assert.Error(t, err)
+		assert.Nil(t, page)
+	})
+
+	t.Run("should return an empty page without cursors when there are no rows", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := cursor.Paginate(cursor.New(cursor.DirectionAfter, "1"), 2, fetchRows(), keys)
+		assert.NoError(t, err)
+		assert.Equal(t, &cursor.Page[int]{}, page)
+	})
+
+	t.Run("should return the first page with a next cursor", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := cursor.Paginate(nil, 2, fetchRows(1, 2, 3), keys)
+		assert.NoError(t, err)
+		assert.Equal(t, &cursor.Page[int]{
+			NextCursor: cursor.New(cursor.DirectionAfter, "2"),
+			Rows:       []int{1, 2},
+		}, page)
+	})
+
+	t.Run("should return the last page with a previous cursor when going forwards", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := cursor.Paginate(cursor.New(cursor.DirectionAfter, "2"), 2, fetchRows(3, 4), keys)
+		assert.NoError(t, err)
+		assert.Equal(t, &cursor.Page[int]{
+			PrevCursor: cursor.New(cursor.DirectionBefore, "3"),
+			Rows:       []int{3, 4},
+		}, page)
+	})
+
+	t.Run("should drop the first row and return both cursors when going backwards with more rows", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := cursor.Paginate(cursor.New(cursor.DirectionBefore, "5"), 2, fetchRows(2, 3, 4), keys)
+		assert.NoError(t, err)
+		assert.Equal(t, &cursor.Page[int]{
+			NextCursor: cursor.New(cursor.DirectionAfter, "4"),
+			PrevCursor: cursor.New(cursor.DirectionBefore, "3"),
+			Rows:       []int{3, 4},
+		}, page)
+	})
+
+	t.Run("should return the first page with a next cursor when going backwards", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := cursor.Paginate(cursor.New(cursor.DirectionBefore, "3"), 2, fetchRows(1, 2), keys)
+		assert.NoError(t, err)
+		assert.Equal(t, &cursor.Page[int]{
+			NextCursor: cursor.New(cursor.DirectionAfter, "2"),
+			Rows:       []int{1, 2},
+		}, page)
+	})
+}
@@ -0,0 +1,76 @@
+package domain
+
+import "context"
+
+// ChangeOperation is how a row was changed.
+type ChangeOperation string
+
+const (
+	ChangeOperationDelete = ChangeOperation("DELETE")
+	ChangeOperationInsert = ChangeOperation("INSERT")
+	ChangeOperationUpdate = ChangeOperation("UPDATE")
+)
+
+// ChangeEvent is a change made to the data that may alter which shifts are
+// available, as notified by the database. It is one of ChangesMissed,
+// DocumentChanged, FacilityChanged, FacilityRequirementChanged, ShiftChanged,
+// WorkerChanged or WorkerDocumentChanged.
+type ChangeEvent interface {
+	changeEvent()
+}
+
+// ChangeHandler is called with every change event, one at a time, so it should
+// return quickly.
+type ChangeHandler func(ctx context.Context, event ChangeEvent)
+
+// ChangesMissed tells changes may have been made without being notified, as
+// the connection to the database was lost for a while, so anything kept from
+// before should be considered stale.
+type ChangesMissed struct{}
+
+// DocumentChanged tells a document of the catalogue was retired or reinstated.
+type DocumentChanged struct {
+	DocumentID int64
+	Operation  ChangeOperation
+}
+
+type FacilityChanged struct {
+	FacilityID int64
+	Operation  ChangeOperation
+}
+
+type FacilityRequirementChanged struct {
+	DocumentID int64
+	FacilityID int64
+	Operation  ChangeOperation
+}
+
+// ShiftChanged tells a shift changed. PreviousWorkerID is who had claimed the
+// shift before an update, so releases can be told apart from claims.
+type ShiftChanged struct {
+	FacilityID       int64
+	Operation        ChangeOperation
+	PreviousWorkerID *int64
+	ShiftID          int64
+	WorkerID         *int64
+}
+
+type WorkerChanged struct {
+	Operation ChangeOperation
+	WorkerID  int64
+}
+
+// WorkerDocumentChanged tells a copy of a document held by a worker changed.
+type WorkerDocumentChanged struct {
+	DocumentID int64
+	Operation  ChangeOperation
+	WorkerID   int64
+}
+
+func (ChangesMissed) changeEvent()              {}
+func (DocumentChanged) changeEvent()            {}
+func (FacilityChanged) changeEvent()            {}
+func (FacilityRequirementChanged) changeEvent() {}
+func (ShiftChanged) changeEvent()               {}
+func (WorkerChanged) changeEvent()              {}
+func (WorkerDocumentChanged) changeEvent()      {}
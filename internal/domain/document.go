@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type DocumentStatus string
+
+const (
+	DocumentStatusPending  = DocumentStatus("PENDING")
+	DocumentStatusRejected = DocumentStatus("REJECTED")
+	DocumentStatusVerified = DocumentStatus("VERIFIED")
+)
+
+type Document struct {
+	ID       int64
+	IsActive bool
+	Name     string
+}
+
+// WorkerDocument is a copy of a document of the catalogue held by a worker.
+// Only verified copies which have not expired count towards eligibility.
+type WorkerDocument struct {
+	Document  Document
+	ExpiresAt *time.Time
+	ID        int64
+	Status    DocumentStatus
+	WorkerID  int64
+}
+
+//go:generate mockgen -destination=internal/domain/document_mock.go -package=domain -source=./internal/domain/document.go DocumentRepository
+type DocumentRepository interface {
+	AddWorkerDocument(ctx context.Context, workerID int64, documentID int64, expiresAt *time.Time) (*WorkerDocument, error)
+	GetNewlyEligibleFacilities(ctx context.Context, workerID int64, documentID int64, at time.Time) ([]Facility, error)
+	GetWorkerDocuments(ctx context.Context, workerID int64) ([]WorkerDocument, error)
+	RemoveWorkerDocument(ctx context.Context, workerID int64, documentID int64) error
+}
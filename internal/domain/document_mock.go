@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/document.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDocumentRepository is a mock of DocumentRepository interface.
+type MockDocumentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDocumentRepositoryMockRecorder
+}
+
+// MockDocumentRepositoryMockRecorder is the mock recorder for MockDocumentRepository.
+type MockDocumentRepositoryMockRecorder struct {
+	mock *MockDocumentRepository
+}
+
+// NewMockDocumentRepository creates a new mock instance.
+func NewMockDocumentRepository(ctrl *gomock.Controller) *MockDocumentRepository {
+	mock := &MockDocumentRepository{ctrl: ctrl}
+	mock.recorder = &MockDocumentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDocumentRepository) EXPECT() *MockDocumentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddWorkerDocument mocks base method.
+func (m *MockDocumentRepository) AddWorkerDocument(ctx context.Context, workerID, documentID int64, expiresAt *time.Time) (*WorkerDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkerDocument", ctx, workerID, documentID, expiresAt)
+	ret0, _ := ret[0].(*WorkerDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkerDocument indicates an expected call of AddWorkerDocument.
+func (mr *MockDocumentRepositoryMockRecorder) AddWorkerDocument(ctx, workerID, documentID, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkerDocument", reflect.TypeOf((*MockDocumentRepository)(nil).AddWorkerDocument), ctx, workerID, documentID, expiresAt)
+}
+
+// GetNewlyEligibleFacilities mocks base method.
+func (m *MockDocumentRepository) GetNewlyEligibleFacilities(ctx context.Context, workerID, documentID int64, at time.Time) ([]Facility, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewlyEligibleFacilities", ctx, workerID, documentID, at)
+	ret0, _ := ret[0].([]Facility)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewlyEligibleFacilities indicates an expected call of GetNewlyEligibleFacilities.
+func (mr *MockDocumentRepositoryMockRecorder) GetNewlyEligibleFacilities(ctx, workerID, documentID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewlyEligibleFacilities", reflect.TypeOf((*MockDocumentRepository)(nil).GetNewlyEligibleFacilities), ctx, workerID, documentID, at)
+}
+
+// GetWorkerDocuments mocks base method.
+func (m *MockDocumentRepository) GetWorkerDocuments(ctx context.Context, workerID int64) ([]WorkerDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerDocuments", ctx, workerID)
+	ret0, _ := ret[0].([]WorkerDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerDocuments indicates an expected call of GetWorkerDocuments.
+func (mr *MockDocumentRepositoryMockRecorder) GetWorkerDocuments(ctx, workerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerDocuments", reflect.TypeOf((*MockDocumentRepository)(nil).GetWorkerDocuments), ctx, workerID)
+}
+
+// RemoveWorkerDocument mocks base method.
+func (m *MockDocumentRepository) RemoveWorkerDocument(ctx context.Context, workerID, documentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWorkerDocument", ctx, workerID, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWorkerDocument indicates an expected call of RemoveWorkerDocument.
+func (mr *MockDocumentRepositoryMockRecorder) RemoveWorkerDocument(ctx, workerID, documentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkerDocument", reflect.TypeOf((*MockDocumentRepository)(nil).RemoveWorkerDocument), ctx, workerID, documentID)
+}
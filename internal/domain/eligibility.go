@@ -0,0 +1,37 @@
+package domain
+
+type EligibilityRule string
+
+const (
+	EligibilityRuleDocumentsHeld   = EligibilityRule("documents_held")
+	EligibilityRuleFacilityActive  = EligibilityRule("facility_active")
+	EligibilityRuleNoOverlap       = EligibilityRule("no_overlap")
+	EligibilityRuleProfessionMatch = EligibilityRule("profession_match")
+	EligibilityRuleShiftActive     = EligibilityRule("shift_active")
+	EligibilityRuleShiftUnclaimed  = EligibilityRule("shift_unclaimed")
+	EligibilityRuleWorkerActive    = EligibilityRule("worker_active")
+)
+
+type EligibilityCheck struct {
+	Passed bool
+	Rule   EligibilityRule
+}
+
+// Eligibility holds the outcome of every eligibility rule for a worker and a
+// shift, along with the documents the worker misses for it, if any.
+type Eligibility struct {
+	Checks             []EligibilityCheck
+	MissingDocumentIDs []int64
+	ShiftID            int64
+	WorkerID           int64
+}
+
+func (e Eligibility) Eligible() bool {
+	for _, c := range e.Checks {
+		if !c.Passed {
+			return false
+		}
+	}
+
+	return true
+}
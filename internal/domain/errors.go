@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"errors"
+)
+
+var (
+	ErrCancellationCutoff       = errors.New("shift is too close to its start to be cancelled")
+	ErrDocumentAlreadyHeld      = errors.New("document is already held by the worker")
+	ErrDocumentExpired          = errors.New("document is already expired")
+	ErrDocumentInactive         = errors.New("document is inactive")
+	ErrDocumentNotFound         = errors.New("document not found")
+	ErrFacilityInactive         = errors.New("facility is inactive")
+	ErrFacilityNotFound         = errors.New("facility not found")
+	ErrInvalidCursor            = errors.New("cursor does not match the query")
+	ErrInvalidDateWindow        = errors.New("date window is invalid")
+	ErrInvalidFacilityStatus    = errors.New("facility status change is invalid")
+	ErrInvalidShift             = errors.New("shift is invalid")
+	ErrInvalidShiftFilter       = errors.New("shift filter is invalid")
+	ErrInvalidShiftTemplate     = errors.New("shift template is invalid")
+	ErrInvalidWorker            = errors.New("worker is invalid")
+	ErrRequirementAlreadyExists = errors.New("document is already required by the facility")
+	ErrRequirementNotFound      = errors.New("requirement not found")
+	ErrShiftAlreadyClaimed      = errors.New("shift is already claimed")
+	ErrShiftNotClaimedByWorker  = errors.New("shift is not claimed by the worker")
+	ErrShiftNotFound            = errors.New("shift not found")
+	ErrShiftTemplateNotFound    = errors.New("shift template not found")
+	ErrWorkerDocumentNotFound   = errors.New("document is not held by the worker")
+	ErrWorkerNotEligible        = errors.New("worker is not eligible for the shift")
+	ErrWorkerNotFound           = errors.New("worker not found")
+)
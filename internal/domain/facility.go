@@ -1,6 +1,98 @@
 package domain
 
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
 type Facility struct {
-	ID   int64
-	Name string
+	ID       int64
+	Name     string
+	TimeZone *time.Location
+}
+
+// FacilityRequirement is a document of the catalogue a facility requires
+// workers to hold to work its shifts of a profession, or of every profession
+// when it has none.
+type FacilityRequirement struct {
+	Document   Document
+	FacilityID int64
+	ID         int64
+	Profession *Profession
+}
+
+// EligibilityImpact counts the active workers of a profession who would gain
+// or lose eligibility for at least one open shift of a facility.
+type EligibilityImpact struct {
+	Gained     int
+	Lost       int
+	Profession Profession
+}
+
+// RequirementChange is the outcome of a change to the requirements of a
+// facility, along with its impact on the eligibility of workers for the
+// facility's open shifts, by profession.
+type RequirementChange struct {
+	Impact      []EligibilityImpact
+	Requirement FacilityRequirement
+}
+
+// FacilityAction is a change to the status of a facility.
+type FacilityAction string
+
+const (
+	FacilityActionActivate   = FacilityAction("ACTIVATE")
+	FacilityActionDeactivate = FacilityAction("DEACTIVATE")
+	FacilityActionSuspend    = FacilityAction("SUSPEND")
+)
+
+// FacilityStatus tells whether a facility is active. A suspended facility is
+// inactive until SuspendedUntil, and active again from then on.
+type FacilityStatus struct {
+	IsActive       bool
+	SuspendedUntil *time.Time
+}
+
+// FacilityStatusChange is an entry of the audit trail of the status of a
+// facility.
+type FacilityStatusChange struct {
+	Action          FacilityAction
+	CancelledClaims bool
+	ChangedAt       time.Time
+	FacilityID      int64
+	ID              int64
+	SuspendedUntil  *time.Time
+}
+
+// AffectedWorker is a worker holding claims on shifts of a facility that were
+// affected by a change to the facility's status.
+type AffectedWorker struct {
+	ShiftIDs []int64
+	WorkerID int64
+}
+
+// FacilityLifecycleChange is the outcome of a change to the status of a
+// facility, along with the workers whose claims it affected.
+type FacilityLifecycleChange struct {
+	AffectedWorkers []AffectedWorker
+	Change          FacilityStatusChange
+	Status          FacilityStatus
+}
+
+//go:generate mockgen -destination=internal/domain/facility_mock.go -package=domain -source=./internal/domain/facility.go FacilityRepository
+type FacilityRepository interface {
+	AddFacilityRequirement(ctx context.Context, facilityID int64, documentID int64, profession *Profession, openAfter time.Time, dryRun bool) (*RequirementChange, error)
+	ChangeFacilityStatus(ctx context.Context, facilityID int64, action FacilityAction, suspendedUntil *time.Time, cancelClaims bool, after time.Time) (*FacilityLifecycleChange, error)
+	CreateShift(ctx context.Context, facilityID int64, start time.Time, end time.Time, profession Profession) (*FacilityShift, error)
+	CreateShiftTemplate(ctx context.Context, template ShiftTemplate) (*ShiftTemplate, error)
+	DeleteShift(ctx context.Context, facilityID int64, shiftID int64, unassign bool) (*FacilityShift, error)
+	GetFacilityRequirements(ctx context.Context, facilityID int64) ([]FacilityRequirement, error)
+	GetFacilityStatusChanges(ctx context.Context, facilityID int64) ([]FacilityStatusChange, error)
+	GetShiftTemplates(ctx context.Context, facilityID int64) ([]ShiftTemplate, error)
+	MaterializeShiftTemplate(ctx context.Context, facilityID int64, templateID int64, start civil.Date, end civil.Date, after time.Time) (*ShiftMaterialization, error)
+	RemoveFacilityRequirement(ctx context.Context, facilityID int64, requirementID int64, openAfter time.Time, dryRun bool) (*RequirementChange, error)
+	UpdateFacilityRequirement(ctx context.Context, facilityID int64, requirementID int64, documentID int64, profession *Profession, openAfter time.Time, dryRun bool) (*RequirementChange, error)
+	UpdateShift(ctx context.Context, facilityID int64, shiftID int64, update ShiftUpdate, unassign bool) (*FacilityShift, error)
 }
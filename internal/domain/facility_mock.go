@@ -0,0 +1,217 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/facility.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	civil "cloud.google.com/go/civil"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFacilityRepository is a mock of FacilityRepository interface.
+type MockFacilityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFacilityRepositoryMockRecorder
+}
+
+// MockFacilityRepositoryMockRecorder is the mock recorder for MockFacilityRepository.
+type MockFacilityRepositoryMockRecorder struct {
+	mock *MockFacilityRepository
+}
+
+// NewMockFacilityRepository creates a new mock instance.
+func NewMockFacilityRepository(ctrl *gomock.Controller) *MockFacilityRepository {
+	mock := &MockFacilityRepository{ctrl: ctrl}
+	mock.recorder = &MockFacilityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFacilityRepository) EXPECT() *MockFacilityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddFacilityRequirement mocks base method.
+func (m *MockFacilityRepository) AddFacilityRequirement(ctx context.Context, facilityID, documentID int64, profession *Profession, openAfter time.Time, dryRun bool) (*RequirementChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFacilityRequirement", ctx, facilityID, documentID, profession, openAfter, dryRun)
+	ret0, _ := ret[0].(*RequirementChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFacilityRequirement indicates an expected call of AddFacilityRequirement.
+func (mr *MockFacilityRepositoryMockRecorder) AddFacilityRequirement(ctx, facilityID, documentID, profession, openAfter, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFacilityRequirement", reflect.TypeOf((*MockFacilityRepository)(nil).AddFacilityRequirement), ctx, facilityID, documentID, profession, openAfter, dryRun)
+}
+
+// ChangeFacilityStatus mocks base method.
+func (m *MockFacilityRepository) ChangeFacilityStatus(ctx context.Context, facilityID int64, action FacilityAction, suspendedUntil *time.Time, cancelClaims bool, after time.Time) (*FacilityLifecycleChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeFacilityStatus", ctx, facilityID, action, suspendedUntil, cancelClaims, after)
+	ret0, _ := ret[0].(*FacilityLifecycleChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeFacilityStatus indicates an expected call of ChangeFacilityStatus.
+func (mr *MockFacilityRepositoryMockRecorder) ChangeFacilityStatus(ctx, facilityID, action, suspendedUntil, cancelClaims, after interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeFacilityStatus", reflect.TypeOf((*MockFacilityRepository)(nil).ChangeFacilityStatus), ctx, facilityID, action, suspendedUntil, cancelClaims, after)
+}
+
+// CreateShift mocks base method.
+func (m *MockFacilityRepository) CreateShift(ctx context.Context, facilityID int64, start, end time.Time, profession Profession) (*FacilityShift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShift", ctx, facilityID, start, end, profession)
+	ret0, _ := ret[0].(*FacilityShift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShift indicates an expected call of CreateShift.
+func (mr *MockFacilityRepositoryMockRecorder) CreateShift(ctx, facilityID, start, end, profession interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShift", reflect.TypeOf((*MockFacilityRepository)(nil).CreateShift), ctx, facilityID, start, end, profession)
+}
+
+// CreateShiftTemplate mocks base method.
+func (m *MockFacilityRepository) CreateShiftTemplate(ctx context.Context, template ShiftTemplate) (*ShiftTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShiftTemplate", ctx, template)
+	ret0, _ := ret[0].(*ShiftTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShiftTemplate indicates an expected call of CreateShiftTemplate.
+func (mr *MockFacilityRepositoryMockRecorder) CreateShiftTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShiftTemplate", reflect.TypeOf((*MockFacilityRepository)(nil).CreateShiftTemplate), ctx, template)
+}
+
+// DeleteShift mocks base method.
+func (m *MockFacilityRepository) DeleteShift(ctx context.Context, facilityID, shiftID int64, unassign bool) (*FacilityShift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShift", ctx, facilityID, shiftID, unassign)
+	ret0, _ := ret[0].(*FacilityShift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteShift indicates an expected call of DeleteShift.
+func (mr *MockFacilityRepositoryMockRecorder) DeleteShift(ctx, facilityID, shiftID, unassign interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShift", reflect.TypeOf((*MockFacilityRepository)(nil).DeleteShift), ctx, facilityID, shiftID, unassign)
+}
+
+// GetFacilityRequirements mocks base method.
+func (m *MockFacilityRepository) GetFacilityRequirements(ctx context.Context, facilityID int64) ([]FacilityRequirement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacilityRequirements", ctx, facilityID)
+	ret0, _ := ret[0].([]FacilityRequirement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacilityRequirements indicates an expected call of GetFacilityRequirements.
+func (mr *MockFacilityRepositoryMockRecorder) GetFacilityRequirements(ctx, facilityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacilityRequirements", reflect.TypeOf((*MockFacilityRepository)(nil).GetFacilityRequirements), ctx, facilityID)
+}
+
+// GetFacilityStatusChanges mocks base method.
+func (m *MockFacilityRepository) GetFacilityStatusChanges(ctx context.Context, facilityID int64) ([]FacilityStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacilityStatusChanges", ctx, facilityID)
+	ret0, _ := ret[0].([]FacilityStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacilityStatusChanges indicates an expected call of GetFacilityStatusChanges.
+func (mr *MockFacilityRepositoryMockRecorder) GetFacilityStatusChanges(ctx, facilityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacilityStatusChanges", reflect.TypeOf((*MockFacilityRepository)(nil).GetFacilityStatusChanges), ctx, facilityID)
+}
+
+// GetShiftTemplates mocks base method.
+func (m *MockFacilityRepository) GetShiftTemplates(ctx context.Context, facilityID int64) ([]ShiftTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShiftTemplates", ctx, facilityID)
+	ret0, _ := ret[0].([]ShiftTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShiftTemplates indicates an expected call of GetShiftTemplates.
+func (mr *MockFacilityRepositoryMockRecorder) GetShiftTemplates(ctx, facilityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShiftTemplates", reflect.TypeOf((*MockFacilityRepository)(nil).GetShiftTemplates), ctx, facilityID)
+}
+
+// MaterializeShiftTemplate mocks base method.
+func (m *MockFacilityRepository) MaterializeShiftTemplate(ctx context.Context, facilityID, templateID int64, start, end civil.Date, after time.Time) (*ShiftMaterialization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaterializeShiftTemplate", ctx, facilityID, templateID, start, end, after)
+	ret0, _ := ret[0].(*ShiftMaterialization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaterializeShiftTemplate indicates an expected call of MaterializeShiftTemplate.
+func (mr *MockFacilityRepositoryMockRecorder) MaterializeShiftTemplate(ctx, facilityID, templateID, start, end, after interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterializeShiftTemplate", reflect.TypeOf((*MockFacilityRepository)(nil).MaterializeShiftTemplate), ctx, facilityID, templateID, start, end, after)
+}
+
+// RemoveFacilityRequirement mocks base method.
+func (m *MockFacilityRepository) RemoveFacilityRequirement(ctx context.Context, facilityID, requirementID int64, openAfter time.Time, dryRun bool) (*RequirementChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFacilityRequirement", ctx, facilityID, requirementID, openAfter, dryRun)
+	ret0, _ := ret[0].(*RequirementChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFacilityRequirement indicates an expected call of RemoveFacilityRequirement.
+func (mr *MockFacilityRepositoryMockRecorder) RemoveFacilityRequirement(ctx, facilityID, requirementID, openAfter, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFacilityRequirement", reflect.TypeOf((*MockFacilityRepository)(nil).RemoveFacilityRequirement), ctx, facilityID, requirementID, openAfter, dryRun)
+}
+
+// UpdateFacilityRequirement mocks base method.
+func (m *MockFacilityRepository) UpdateFacilityRequirement(ctx context.Context, facilityID, requirementID, documentID int64, profession *Profession, openAfter time.Time, dryRun bool) (*RequirementChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFacilityRequirement", ctx, facilityID, requirementID, documentID, profession, openAfter, dryRun)
+	ret0, _ := ret[0].(*RequirementChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFacilityRequirement indicates an expected call of UpdateFacilityRequirement.
+func (mr *MockFacilityRepositoryMockRecorder) UpdateFacilityRequirement(ctx, facilityID, requirementID, documentID, profession, openAfter, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFacilityRequirement", reflect.TypeOf((*MockFacilityRepository)(nil).UpdateFacilityRequirement), ctx, facilityID, requirementID, documentID, profession, openAfter, dryRun)
+}
+
+// UpdateShift mocks base method.
+func (m *MockFacilityRepository) UpdateShift(ctx context.Context, facilityID, shiftID int64, update ShiftUpdate, unassign bool) (*FacilityShift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShift", ctx, facilityID, shiftID, update, unassign)
+	ret0, _ := ret[0].(*FacilityShift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShift indicates an expected call of UpdateShift.
+func (mr *MockFacilityRepositoryMockRecorder) UpdateShift(ctx, facilityID, shiftID, update, unassign interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShift", reflect.TypeOf((*MockFacilityRepository)(nil).UpdateShift), ctx, facilityID, shiftID, update, unassign)
+}
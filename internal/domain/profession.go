@@ -0,0 +1,18 @@
+package domain
+
+type Profession string
+
+const (
+	ProfessionCNA = Profession("CNA")
+	ProfessionLVN = Profession("LVN")
+	ProfessionRN  = Profession("RN")
+)
+
+func (p Profession) Valid() bool {
+	switch p {
+	case ProfessionCNA, ProfessionLVN, ProfessionRN:
+		return true
+	default:
+		return false
+	}
+}
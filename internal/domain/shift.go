@@ -1,7 +1,13 @@
 package domain
 
 import (
+	"context"
+	"strconv"
 	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+
+	"cloud.google.com/go/civil"
 )
 
 type Shift struct {
@@ -12,3 +18,66 @@ type Shift struct {
 }
 
 type Shifts []Shift
+
+// FacilityShift is a shift as managed by its facility, along with the
+// profession it requires and the worker who claimed it, if any.
+type FacilityShift struct {
+	Profession Profession
+	Shift      Shift
+	WorkerID   *int64
+}
+
+// ShiftUpdate holds the changes to a shift. Nil fields are left unchanged.
+type ShiftUpdate struct {
+	End        *time.Time
+	Profession *Profession
+	Start      *time.Time
+}
+
+//go:generate mockgen -destination=internal/domain/shift_mock.go -package=domain -source=./internal/domain/shift.go ShiftRepository
+type ShiftRepository interface {
+	GetEligibleWorkers(ctx context.Context, queryCursor *cursor.Cursor, limit int, shiftID int64) ([]Worker, error)
+}
+
+// ShiftFilter narrows down the shifts listed. Dates and times of day are in
+// the time zone of each shift's facility, and bounds are inclusive. Zero
+// values don't filter.
+type ShiftFilter struct {
+	End         civil.Date
+	FacilityIDs []int64
+	MaxDuration time.Duration
+	MinDuration time.Duration
+	Start       civil.Date
+	// StartTimeFrom and StartTimeTo bound the time of day shifts start at.
+	// When StartTimeFrom is after StartTimeTo the range wraps around midnight.
+	StartTimeFrom *civil.Time
+	StartTimeTo   *civil.Time
+}
+
+// ShiftSort is the order shifts are listed in. Shifts are always ordered by ID
+// last, so that cursors over them point at a single shift.
+type ShiftSort string
+
+const (
+	// ShiftSortEnd orders shifts by end and ID.
+	ShiftSortEnd = ShiftSort("end")
+	// ShiftSortFacility orders shifts by facility name, start and ID.
+	ShiftSortFacility = ShiftSort("facility")
+	// ShiftSortStart orders shifts by start and ID.
+	ShiftSortStart = ShiftSort("start")
+)
+
+// CursorKeys returns the values the given shift is sorted by, in order, to be
+// used as cursor keys. Times are formatted as RFC 3339.
+func (ss ShiftSort) CursorKeys(s Shift) []string {
+	id := strconv.FormatInt(s.ID, 10)
+
+	switch ss {
+	case ShiftSortEnd:
+		return []string{s.End.Format(time.RFC3339Nano), id}
+	case ShiftSortFacility:
+		return []string{s.Facility.Name, s.Start.Format(time.RFC3339Nano), id}
+	default:
+		return []string{s.Start.Format(time.RFC3339Nano), id}
+	}
+}
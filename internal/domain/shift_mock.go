@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/shift.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cursor "github.com/rodrigosdo/facilities-api/internal/cursor"
+)
+
+// MockShiftRepository is a mock of ShiftRepository interface.
+type MockShiftRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShiftRepositoryMockRecorder
+}
+
+// MockShiftRepositoryMockRecorder is the mock recorder for MockShiftRepository.
+type MockShiftRepositoryMockRecorder struct {
+	mock *MockShiftRepository
+}
+
+// NewMockShiftRepository creates a new mock instance.
+func NewMockShiftRepository(ctrl *gomock.Controller) *MockShiftRepository {
+	mock := &MockShiftRepository{ctrl: ctrl}
+	mock.recorder = &MockShiftRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShiftRepository) EXPECT() *MockShiftRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetEligibleWorkers mocks base method.
+func (m *MockShiftRepository) GetEligibleWorkers(ctx context.Context, queryCursor *cursor.Cursor, limit int, shiftID int64) ([]Worker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEligibleWorkers", ctx, queryCursor, limit, shiftID)
+	ret0, _ := ret[0].([]Worker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEligibleWorkers indicates an expected call of GetEligibleWorkers.
+func (mr *MockShiftRepositoryMockRecorder) GetEligibleWorkers(ctx, queryCursor, limit, shiftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEligibleWorkers", reflect.TypeOf((*MockShiftRepository)(nil).GetEligibleWorkers), ctx, queryCursor, limit, shiftID)
+}
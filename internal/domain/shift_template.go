@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+// ShiftTemplate recurs on the given days of the week, posting Headcount
+// identical shifts of the profession which start at StartTime, in the time
+// zone of the facility, and last Duration. Like recurrence rules, it recurs
+// from StartsOn, if set, and only Count times, if set, which requires StartsOn
+// to count from.
+type ShiftTemplate struct {
+	Count      *int
+	DaysOfWeek []time.Weekday
+	Duration   time.Duration
+	FacilityID int64
+	Headcount  int
+	ID         int64
+	Profession Profession
+	StartTime  civil.Time
+	StartsOn   *civil.Date
+}
+
+// ShiftMaterialization is the outcome of materializing a shift template over a
+// window of dates. Shifts materialized before are counted as Existing rather
+// than created again.
+type ShiftMaterialization struct {
+	Created  []FacilityShift
+	Existing int
+}
+
+// Occurrences returns the times the template's shifts start at, in loc, on the
+// dates between start and end, both inclusive.
+func (st ShiftTemplate) Occurrences(start civil.Date, end civil.Date, loc *time.Location) []time.Time {
+	var occurrences []time.Time
+
+	// Occurrences are counted from StartsOn, even those before start.
+	from := start
+	if st.StartsOn != nil && (st.Count != nil || st.StartsOn.After(start)) {
+		from = *st.StartsOn
+	}
+
+	counted := 0
+	for date := from; !date.After(end); date = date.AddDays(1) {
+		if !st.recursOn(date.In(loc).Weekday()) {
+			continue
+		}
+
+		if st.Count != nil {
+			if counted == *st.Count {
+				break
+			}
+
+			counted++
+		}
+
+		if date.Before(start) {
+			continue
+		}
+
+		occurrences = append(occurrences, civil.DateTime{Date: date, Time: st.StartTime}.In(loc))
+	}
+
+	return occurrences
+}
+
+func (st ShiftTemplate) recursOn(weekday time.Weekday) bool {
+	for _, d := range st.DaysOfWeek {
+		if d == weekday {
+			return true
+		}
+	}
+
+	return false
+}
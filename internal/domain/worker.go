@@ -2,13 +2,38 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/rodrigosdo/facilities-api/internal/cursor"
-
-	"cloud.google.com/go/civil"
 )
 
+type Worker struct {
+	ID         int64
+	IsActive   bool
+	Name       string
+	Profession Profession
+}
+
+// WorkerUpdate holds the changes to a worker's profile. Nil fields are left
+// as they are.
+type WorkerUpdate struct {
+	IsActive   *bool
+	Profession *Profession
+}
+
+// WorkerChange is the outcome of an update to a worker, along with the future
+// shifts the worker was released from when deactivated.
+type WorkerChange struct {
+	ReleasedShifts []Shift
+	Worker         Worker
+}
+
 //go:generate mockgen -destination=internal/domain/worker_mock.go -package=domain -source=./internal/domain/worker.go WorkerRepository
 type WorkerRepository interface {
-	GetAvailableShifts(ctx context.Context, queryCursor *cursor.Cursor, limit int, workerID int64, start civil.Date, end civil.Date) (Shifts, error)
+	CancelShift(ctx context.Context, workerID int64, shiftID int64, startsAfter time.Time) (*Shift, error)
+	ClaimShift(ctx context.Context, workerID int64, shiftID int64) (*Shift, error)
+	GetAvailableShifts(ctx context.Context, queryCursor *cursor.Cursor, limit int, workerID int64, filter ShiftFilter, sort ShiftSort) (Shifts, error)
+	GetShiftEligibility(ctx context.Context, workerID int64, shiftID int64) (*Eligibility, error)
+	GetWorker(ctx context.Context, workerID int64) (*Worker, error)
+	UpdateWorker(ctx context.Context, workerID int64, update WorkerUpdate, releaseAfter time.Time) (*WorkerChange, error)
 }
@@ -7,8 +7,8 @@ package domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
-	civil "cloud.google.com/go/civil"
 	gomock "github.com/golang/mock/gomock"
 	cursor "github.com/rodrigosdo/facilities-api/internal/cursor"
 )
@@ -36,17 +36,92 @@ func (m *MockWorkerRepository) EXPECT() *MockWorkerRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CancelShift mocks base method.
+func (m *MockWorkerRepository) CancelShift(ctx context.Context, workerID, shiftID int64, startsAfter time.Time) (*Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelShift", ctx, workerID, shiftID, startsAfter)
+	ret0, _ := ret[0].(*Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelShift indicates an expected call of CancelShift.
+func (mr *MockWorkerRepositoryMockRecorder) CancelShift(ctx, workerID, shiftID, startsAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelShift", reflect.TypeOf((*MockWorkerRepository)(nil).CancelShift), ctx, workerID, shiftID, startsAfter)
+}
+
+// ClaimShift mocks base method.
+func (m *MockWorkerRepository) ClaimShift(ctx context.Context, workerID, shiftID int64) (*Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimShift", ctx, workerID, shiftID)
+	ret0, _ := ret[0].(*Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimShift indicates an expected call of ClaimShift.
+func (mr *MockWorkerRepositoryMockRecorder) ClaimShift(ctx, workerID, shiftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimShift", reflect.TypeOf((*MockWorkerRepository)(nil).ClaimShift), ctx, workerID, shiftID)
+}
+
 // GetAvailableShifts mocks base method.
-func (m *MockWorkerRepository) GetAvailableShifts(ctx context.Context, queryCursor *cursor.Cursor, limit int, workerID int64, start, end civil.Date) (Shifts, error) {
+func (m *MockWorkerRepository) GetAvailableShifts(ctx context.Context, queryCursor *cursor.Cursor, limit int, workerID int64, filter ShiftFilter, sort ShiftSort) (Shifts, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAvailableShifts", ctx, queryCursor, limit, workerID, start, end)
+	ret := m.ctrl.Call(m, "GetAvailableShifts", ctx, queryCursor, limit, workerID, filter, sort)
 	ret0, _ := ret[0].(Shifts)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAvailableShifts indicates an expected call of GetAvailableShifts.
-func (mr *MockWorkerRepositoryMockRecorder) GetAvailableShifts(ctx, queryCursor, limit, workerID, start, end interface{}) *gomock.Call {
+func (mr *MockWorkerRepositoryMockRecorder) GetAvailableShifts(ctx, queryCursor, limit, workerID, filter, sort interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableShifts", reflect.TypeOf((*MockWorkerRepository)(nil).GetAvailableShifts), ctx, queryCursor, limit, workerID, filter, sort)
+}
+
+// GetShiftEligibility mocks base method.
+func (m *MockWorkerRepository) GetShiftEligibility(ctx context.Context, workerID, shiftID int64) (*Eligibility, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShiftEligibility", ctx, workerID, shiftID)
+	ret0, _ := ret[0].(*Eligibility)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShiftEligibility indicates an expected call of GetShiftEligibility.
+func (mr *MockWorkerRepositoryMockRecorder) GetShiftEligibility(ctx, workerID, shiftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShiftEligibility", reflect.TypeOf((*MockWorkerRepository)(nil).GetShiftEligibility), ctx, workerID, shiftID)
+}
+
+// GetWorker mocks base method.
+func (m *MockWorkerRepository) GetWorker(ctx context.Context, workerID int64) (*Worker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorker", ctx, workerID)
+	ret0, _ := ret[0].(*Worker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorker indicates an expected call of GetWorker.
+func (mr *MockWorkerRepositoryMockRecorder) GetWorker(ctx, workerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorker", reflect.TypeOf((*MockWorkerRepository)(nil).GetWorker), ctx, workerID)
+}
+
+// UpdateWorker mocks base method.
+func (m *MockWorkerRepository) UpdateWorker(ctx context.Context, workerID int64, update WorkerUpdate, releaseAfter time.Time) (*WorkerChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorker", ctx, workerID, update, releaseAfter)
+	ret0, _ := ret[0].(*WorkerChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorker indicates an expected call of UpdateWorker.
+func (mr *MockWorkerRepositoryMockRecorder) UpdateWorker(ctx, workerID, update, releaseAfter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableShifts", reflect.TypeOf((*MockWorkerRepository)(nil).GetAvailableShifts), ctx, queryCursor, limit, workerID, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorker", reflect.TypeOf((*MockWorkerRepository)(nil).UpdateWorker), ctx, workerID, update, releaseAfter)
 }
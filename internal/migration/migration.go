@@ -0,0 +1,338 @@
+// Package migration applies the schema changes owned by this service on top of
+// the schema set up by the seed project.
+//
+// Migrations are pairs of SQL files named like 0001_name.up.sql and
+// 0001_name.down.sql, applied in version order. Applied versions are recorded
+// in the schema_migrations table.
+package migration
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:embed sql/*.sql
+var embedded embed.FS
+
+// lockNamespace is the first key of the advisory lock taken while migrating,
+// apart from the ones taken by the postgres package and 4, taken by the
+// refreshes of WorkerFacilityEligibility.
+const lockNamespace = 3
+
+// noTransaction starts the scripts to be run outside of a transaction.
+const noTransaction = "-- migration: no transaction"
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Down    string
+	Name    string
+	Up      string
+	Version int64
+}
+
+// Status tells whether a migration was applied, and when.
+type Status struct {
+	AppliedAt *time.Time
+	Migration Migration
+}
+
+type Migrator struct {
+	migrations []Migration
+	pool       *pgxpool.Pool
+}
+
+// New builds a Migrator applying the migrations embedded in the service.
+func New(pool *pgxpool.Pool) (*Migrator, error) {
+	sqlFS, err := fs.Sub(embedded, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := Load(sqlFS)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		migrations: migrations,
+		pool:       pool,
+	}, nil
+}
+
+// Load reads the migrations in the root of fsys, ordered by version. Every
+// migration must have both an up and a down file, and versions must be unique.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %q is not named like 0001_name.up.sql", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration file %q: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(".", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Name: match[2], Version: version}
+			byVersion[version] = m
+		}
+
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d is named both %q and %q", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both an up and a down file", m.Version, m.Name)
+		}
+
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up applies every pending migration in version order, returning the ones
+// applied.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	applied := []Migration{}
+
+	err := m.locked(ctx, func(conn *pgxpool.Conn) error {
+		for _, migration := range m.migrations {
+			appliedAt, err := getAppliedAt(ctx, conn, migration.Version)
+			if err != nil {
+				return err
+			}
+
+			if appliedAt != nil {
+				continue
+			}
+
+			err = run(ctx, conn, migration.Up, func(q querier) error {
+				_, err := q.Exec(ctx, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, migration.Version, migration.Name)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("applying migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down reverts the latest applied migration, returning it, or nil when none
+// was applied.
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	var reverted *Migration
+
+	err := m.locked(ctx, func(conn *pgxpool.Conn) error {
+		var version int64
+		if err := conn.QueryRow(ctx, `SELECT version FROM schema_migrations ORDER BY version DESC LIMIT 1`).Scan(&version); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+
+			return err
+		}
+
+		migration, ok := m.find(version)
+		if !ok {
+			return fmt.Errorf("applied migration %d is unknown to this version of the service", version)
+		}
+
+		err := run(ctx, conn, migration.Down, func(q querier) error {
+			_, err := q.Exec(ctx, `DELETE FROM schema_migrations WHERE version = $1`, version)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("reverting migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		reverted = &migration
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reverted, nil
+}
+
+// Status returns every migration along with when it was applied, if it was.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if err := createTable(ctx, m.pool); err != nil {
+		return nil, err
+	}
+
+	rows, err := m.pool.Query(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	appliedAt := map[int64]time.Time{}
+	for rows.Next() {
+		var (
+			version int64
+			at      time.Time
+		)
+
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, err
+		}
+
+		appliedAt[version] = at
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+
+		if at, ok := appliedAt[migration.Version]; ok {
+			status.AppliedAt = &at
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// locked runs f on a connection holding the migration lock for as long as it
+// runs, so concurrent instances migrating at once apply every migration
+// exactly once. The lock is tied to the session rather than to a transaction,
+// as some migrations can't run in one.
+func (m *Migrator) locked(ctx context.Context, f func(conn *pgxpool.Conn) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if err := createTable(ctx, conn); err != nil {
+		return err
+	}
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1, 0)", lockNamespace); err != nil {
+		return err
+	}
+	defer conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1, 0)", lockNamespace)
+
+	return f(conn)
+}
+
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// run runs the script and then record, both in the same transaction, unless
+// the script starts with noTransaction. It then runs on its own, as a single
+// statement, so it can hold statements like CREATE INDEX CONCURRENTLY.
+func run(ctx context.Context, conn *pgxpool.Conn, script string, record func(q querier) error) error {
+	if !strings.HasPrefix(script, noTransaction) {
+		return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, script); err != nil {
+				return err
+			}
+
+			return record(tx)
+		})
+	}
+
+	// Sent through the extended protocol, which refuses several statements,
+	// as the simple one would run them in an implicit transaction.
+	if _, err := conn.Exec(ctx, script, pgx.QueryExecModeExec); err != nil {
+		return err
+	}
+
+	return record(conn)
+}
+
+func createTable(ctx context.Context, q querier) error {
+	_, err := q.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`)
+
+	return err
+}
+
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+
+	return Migration{}, false
+}
+
+func getAppliedAt(ctx context.Context, q querier, version int64) (*time.Time, error) {
+	var appliedAt time.Time
+	if err := q.QueryRow(ctx, `SELECT applied_at FROM schema_migrations WHERE version = $1`, version).Scan(&appliedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &appliedAt, nil
+}
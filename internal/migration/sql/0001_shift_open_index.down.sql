@@ -0,0 +1,2 @@
+-- migration: no transaction
+DROP INDEX CONCURRENTLY IF EXISTS "Shift_open_facility_id_profession_start_idx";
@@ -0,0 +1,4 @@
+-- migration: no transaction
+-- Built concurrently so writes to shifts go on meanwhile. Should it fail, the
+-- invalid index left behind must be dropped before applying it again.
+CREATE INDEX CONCURRENTLY "Shift_open_facility_id_profession_start_idx" ON "Shift" ("facility_id", "profession", "start") WHERE "worker_id" IS NULL AND NOT "is_deleted";
@@ -0,0 +1,2 @@
+-- migration: no transaction
+DROP INDEX CONCURRENTLY IF EXISTS "DocumentWorker_worker_id_idx";
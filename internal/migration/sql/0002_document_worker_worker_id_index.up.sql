@@ -0,0 +1,4 @@
+-- migration: no transaction
+-- Built concurrently so writes to worker documents go on meanwhile. Should it
+-- fail, the invalid index left behind must be dropped before applying it again.
+CREATE INDEX CONCURRENTLY "DocumentWorker_worker_id_idx" ON "DocumentWorker" ("worker_id");
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS facility_requirement_notify_change ON "FacilityRequirement";
+DROP TRIGGER IF EXISTS document_worker_notify_change ON "DocumentWorker";
+DROP TRIGGER IF EXISTS worker_notify_change ON "Worker";
+DROP TRIGGER IF EXISTS facility_notify_change ON "Facility";
+DROP TRIGGER IF EXISTS shift_notify_change ON "Shift";
+DROP FUNCTION IF EXISTS notify_change();
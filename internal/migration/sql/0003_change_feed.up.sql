@@ -0,0 +1,46 @@
+-- Notifies listeners on the "changes" channel of every row changed in the
+-- tables whose changes alter which shifts are available. The payload holds
+-- the table, the operation and the row, along with the row as it was before
+-- updates.
+CREATE OR REPLACE FUNCTION notify_change() RETURNS trigger
+LANGUAGE plpgsql AS $$
+DECLARE
+    payload JSONB;
+BEGIN
+    payload := jsonb_build_object('table', TG_TABLE_NAME, 'operation', TG_OP);
+
+    IF TG_OP = 'DELETE' THEN
+        payload := payload || jsonb_build_object('row', to_jsonb(OLD));
+    ELSE
+        payload := payload || jsonb_build_object('row', to_jsonb(NEW));
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        payload := payload || jsonb_build_object('old', to_jsonb(OLD));
+    END IF;
+
+    PERFORM pg_notify('changes', payload::TEXT);
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER shift_notify_change
+AFTER INSERT OR UPDATE OR DELETE ON "Shift"
+FOR EACH ROW EXECUTE FUNCTION notify_change();
+
+CREATE TRIGGER facility_notify_change
+AFTER INSERT OR UPDATE OR DELETE ON "Facility"
+FOR EACH ROW EXECUTE FUNCTION notify_change();
+
+CREATE TRIGGER worker_notify_change
+AFTER INSERT OR UPDATE OR DELETE ON "Worker"
+FOR EACH ROW EXECUTE FUNCTION notify_change();
+
+CREATE TRIGGER document_worker_notify_change
+AFTER INSERT OR UPDATE OR DELETE ON "DocumentWorker"
+FOR EACH ROW EXECUTE FUNCTION notify_change();
+
+CREATE TRIGGER facility_requirement_notify_change
+AFTER INSERT OR UPDATE OR DELETE ON "FacilityRequirement"
+FOR EACH ROW EXECUTE FUNCTION notify_change();
@@ -0,0 +1 @@
+DROP TRIGGER IF EXISTS document_notify_change ON "Document";
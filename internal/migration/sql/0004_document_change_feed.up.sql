@@ -0,0 +1,7 @@
+-- Notifies documents of the catalogue being retired or reinstated, as only
+-- active documents are required by facilities and count when held by workers.
+CREATE TRIGGER document_notify_change
+AFTER UPDATE OF is_active ON "Document"
+FOR EACH ROW
+WHEN (OLD.is_active IS DISTINCT FROM NEW.is_active)
+EXECUTE FUNCTION notify_change();
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/rodrigosdo/facilities-api/internal/config"
 
@@ -20,12 +21,14 @@ type Conn interface {
 
 type Database struct {
 	*pgxpool.Pool
-	sq squirrel.StatementBuilderType
+	minRestPeriod time.Duration
+	sq            squirrel.StatementBuilderType
 }
 
 func NewDatabase(
 	ctx context.Context,
 	cfg config.Database,
+	shiftCfg config.Shift,
 ) (*Database, error) {
 	c, err := pgxpool.ParseConfig(cfg.DSN)
 	if err != nil {
@@ -38,8 +41,9 @@ func NewDatabase(
 	}
 
 	return &Database{
-		Pool: pool,
-		sq:   squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		Pool:          pool,
+		minRestPeriod: shiftCfg.MinRestPeriod,
+		sq:            squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
 	}, nil
 }
 
@@ -0,0 +1,334 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetWorkerDocuments returns every copy of a document held by the worker,
+// whatever its status or expiry.
+func (d *Database) GetWorkerDocuments(ctx context.Context, workerID int64) ([]domain.WorkerDocument, error) {
+	if err := d.checkWorkerExists(ctx, d.Pool, workerID); err != nil {
+		return nil, err
+	}
+
+	sql, args, err := d.workerDocumentsWithDocument().
+		Where("dw.worker_id = ?", workerID).
+		OrderBy("doc.id", "dw.id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return deserializeWorkerDocuments(rows)
+}
+
+// AddWorkerDocument records a copy of an active document of the catalogue for
+// the worker, pending verification. It is refused when the worker already
+// holds a copy pending verification, or a verified one valid for at least as
+// long.
+func (d *Database) AddWorkerDocument(ctx context.Context, workerID int64, documentID int64, expiresAt *time.Time) (*domain.WorkerDocument, error) {
+	var workerDocument *domain.WorkerDocument
+
+	err := pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		// Serialized with the worker's other changes, so concurrent requests
+		// can't add the same document twice.
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1, $2)", workerLockNamespace, workerID); err != nil {
+			return err
+		}
+
+		if err := d.checkWorkerExists(ctx, tx, workerID); err != nil {
+			return err
+		}
+
+		if err := d.checkDocumentActive(ctx, tx, documentID); err != nil {
+			return err
+		}
+
+		if err := d.checkWorkerDocumentNotHeld(ctx, tx, workerID, documentID, expiresAt); err != nil {
+			return err
+		}
+
+		var expiresAtArg interface{}
+		if expiresAt != nil {
+			expiresAtArg = expiresAt.UTC()
+		}
+
+		sql, args, err := d.sq.
+			Insert("\"DocumentWorker\"").
+			Columns("worker_id", "document_id", "expires_at").
+			Values(workerID, documentID, expiresAtArg).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var workerDocumentID int64
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&workerDocumentID); err != nil {
+			return err
+		}
+
+		sql, args, err = d.workerDocumentsWithDocument().
+			Where("dw.id = ?", workerDocumentID).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		workerDocuments, err := deserializeWorkerDocuments(rows)
+		if err != nil {
+			return err
+		}
+
+		if len(workerDocuments) == 0 {
+			return errors.New("added worker document could not be found")
+		}
+
+		workerDocument = &workerDocuments[0]
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return workerDocument, nil
+}
+
+// RemoveWorkerDocument removes every copy of the document held by the worker.
+func (d *Database) RemoveWorkerDocument(ctx context.Context, workerID int64, documentID int64) error {
+	return pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		// Serialized with the worker's other changes, so a copy being added
+		// concurrently is either removed too or added afterwards.
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1, $2)", workerLockNamespace, workerID); err != nil {
+			return err
+		}
+
+		sql, args, err := d.sq.
+			Delete("\"DocumentWorker\"").
+			Where("worker_id = ?", workerID).
+			Where("document_id = ?", documentID).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			if err := d.checkWorkerExists(ctx, tx, workerID); err != nil {
+				return err
+			}
+
+			return domain.ErrWorkerDocumentNotFound
+		}
+
+		return nil
+	})
+}
+
+// GetNewlyEligibleFacilities returns the active facilities the worker would
+// become eligible for, document wise, by holding a valid copy of the given
+// document at the given time, on top of the valid ones already held. Only the
+// documents facilities require for the worker's profession are considered.
+// Facilities the worker is already eligible for are left out.
+func (d *Database) GetNewlyEligibleFacilities(ctx context.Context, workerID int64, documentID int64, at time.Time) ([]domain.Facility, error) {
+	sql, args, err := d.sq.
+		Select(
+			"f.id",
+			"f.name",
+			"f.time_zone",
+		).
+		PrefixExpr(d.facilityDocuments().Prefix("WITH facility_documents AS (").Suffix("),")).
+		PrefixExpr(d.workerDocuments(workerID).Prefix("worker_documents AS (").Suffix(")")).
+		From("\"Facility\" f").
+		InnerJoin("\"Worker\" w ON w.id = ?", workerID).
+		InnerJoin("facility_documents fd ON fd.facility_id = f.id AND fd.profession = w.profession").
+		Where(activeFacility).
+		Where("NOT fd.required_documents <@ ARRAY("+validDocuments("?")+")", at.UTC()).
+		Where("fd.required_documents <@ (ARRAY("+validDocuments("?")+") || ?::integer)", at.UTC(), documentID).
+		OrderBy("f.id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return deserializeFacilities(rows)
+}
+
+// checkDocumentActive returns domain.ErrDocumentNotFound when the document is
+// not in the catalogue, or domain.ErrDocumentInactive when it was retired.
+func (d *Database) checkDocumentActive(ctx context.Context, q rowQuerier, documentID int64) error {
+	sql, args, err := d.sq.
+		Select("is_active").
+		From("\"Document\"").
+		Where("id = ?", documentID).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var isActive bool
+	if err := q.QueryRow(ctx, sql, args...).Scan(&isActive); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrDocumentNotFound
+		}
+
+		return err
+	}
+
+	if !isActive {
+		return domain.ErrDocumentInactive
+	}
+
+	return nil
+}
+
+// checkWorkerDocumentNotHeld returns domain.ErrDocumentAlreadyHeld when the
+// worker holds a copy of the document pending verification, or a verified one
+// valid for at least as long as a copy expiring at expiresAt.
+func (d *Database) checkWorkerDocumentNotHeld(ctx context.Context, q rowQuerier, workerID int64, documentID int64, expiresAt *time.Time) error {
+	validAsLong := squirrel.Sqlizer(squirrel.Eq{"expires_at": nil})
+	if expiresAt != nil {
+		validAsLong = squirrel.Or{
+			squirrel.Eq{"expires_at": nil},
+			squirrel.GtOrEq{"expires_at": expiresAt.UTC()},
+		}
+	}
+
+	sql, args, err := d.sq.
+		Select("1").
+		From("\"DocumentWorker\"").
+		Where("worker_id = ?", workerID).
+		Where("document_id = ?", documentID).
+		Where(squirrel.Or{
+			squirrel.Expr("status = 'PENDING'"),
+			squirrel.And{
+				squirrel.Expr("status = 'VERIFIED'"),
+				validAsLong,
+			},
+		}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var found int
+	if err := q.QueryRow(ctx, sql, args...).Scan(&found); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	return domain.ErrDocumentAlreadyHeld
+}
+
+// workerDocumentsWithDocument selects from the documents held by workers,
+// aliased as dw, along with the document of the catalogue (doc) they are a
+// copy of.
+func (d *Database) workerDocumentsWithDocument() squirrel.SelectBuilder {
+	return d.sq.
+		Select(
+			"dw.id",
+			"dw.worker_id",
+			"dw.status::text",
+			"dw.expires_at",
+			"doc.id",
+			"doc.name",
+			"doc.is_active",
+		).
+		From("\"DocumentWorker\" dw").
+		InnerJoin("\"Document\" doc ON doc.id = dw.document_id")
+}
+
+func deserializeWorkerDocuments(rows pgx.Rows) ([]domain.WorkerDocument, error) {
+	defer rows.Close()
+
+	workerDocuments := []domain.WorkerDocument{}
+	for rows.Next() {
+		wd := domain.WorkerDocument{}
+
+		if err := rows.Scan(
+			&wd.ID,
+			&wd.WorkerID,
+			&wd.Status,
+			&wd.ExpiresAt,
+			&wd.Document.ID,
+			&wd.Document.Name,
+			&wd.Document.IsActive,
+		); err != nil {
+			return nil, err
+		}
+
+		workerDocuments = append(workerDocuments, wd)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return workerDocuments, nil
+}
+
+func deserializeFacilities(rows pgx.Rows) ([]domain.Facility, error) {
+	defer rows.Close()
+
+	facilities := []domain.Facility{}
+	for rows.Next() {
+		f := domain.Facility{}
+
+		var timeZone string
+
+		if err := rows.Scan(
+			&f.ID,
+			&f.Name,
+			&timeZone,
+		); err != nil {
+			return nil, err
+		}
+
+		loc, err := loadLocation(timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("facility %d: %w", f.ID, err)
+		}
+
+		f.TimeZone = loc
+
+		facilities = append(facilities, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return facilities, nil
+}
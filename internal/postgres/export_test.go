@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+// AvailableShiftsSQL exposes the query of GetAvailableShifts, so tests can
+// check how it is planned.
+func (d *Database) AvailableShiftsSQL(queryCursor *cursor.Cursor, limit int, workerID int64, filter domain.ShiftFilter, sort domain.ShiftSort) (string, []interface{}, error) {
+	return d.availableShiftsSQL(queryCursor, limit, workerID, filter, sort)
+}
+
+// FilteredShiftIDsSQL exposes the predicates of the filter over every shift,
+// selecting their IDs in order, so tests can compare them with other ones.
+func (d *Database) FilteredShiftIDsSQL(filter domain.ShiftFilter) (string, []interface{}, error) {
+	return d.sq.
+		Select("s.id").
+		From("\"Shift\" s").
+		InnerJoin("\"Facility\" f ON f.id = s.facility_id").
+		Where(shiftFilterPredicates(filter)).
+		OrderBy("s.id").
+		ToSql()
+}
+
+// DecodeChange exposes how notified changes are decoded into domain events.
+var DecodeChange = decodeChange
@@ -0,0 +1,746 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// facilityLockNamespace is the first key of the advisory locks taken on
+// facilities.
+const facilityLockNamespace = 2
+
+// activeFacility tells whether the facility, aliased as f, is active. A
+// suspended facility is inactive until its suspension ends, and active again
+// from then on without any further change.
+const activeFacility = "(f.is_active = TRUE AND (f.suspended_until IS NULL OR f.suspended_until <= (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')))"
+
+// GetFacilityRequirements returns the documents required by the facility,
+// inactive ones included.
+func (d *Database) GetFacilityRequirements(ctx context.Context, facilityID int64) ([]domain.FacilityRequirement, error) {
+	if err := d.checkFacilityExists(ctx, d.Pool, facilityID); err != nil {
+		return nil, err
+	}
+
+	sql, args, err := d.facilityRequirementsWithDocument().
+		Where("fr.facility_id = ?", facilityID).
+		OrderBy("fr.id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return deserializeFacilityRequirements(rows)
+}
+
+// AddFacilityRequirement requires an active document of the catalogue for the
+// facility's shifts of the given profession, or of every profession when nil.
+func (d *Database) AddFacilityRequirement(ctx context.Context, facilityID int64, documentID int64, profession *domain.Profession, openAfter time.Time, dryRun bool) (*domain.RequirementChange, error) {
+	return d.changeFacilityRequirements(ctx, facilityID, openAfter, dryRun, func(tx pgx.Tx) (*domain.FacilityRequirement, error) {
+		if err := d.checkDocumentActive(ctx, tx, documentID); err != nil {
+			return nil, err
+		}
+
+		if err := d.checkDocumentNotRequired(ctx, tx, facilityID, documentID, profession, 0); err != nil {
+			return nil, err
+		}
+
+		sql, args, err := d.sq.
+			Insert("\"FacilityRequirement\"").
+			Columns("facility_id", "document_id", "profession").
+			Values(facilityID, documentID, professionArg(profession)).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		var requirementID int64
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&requirementID); err != nil {
+			return nil, err
+		}
+
+		return d.getFacilityRequirement(ctx, tx, facilityID, requirementID)
+	})
+}
+
+// UpdateFacilityRequirement replaces the document and the profession of a
+// requirement of the facility.
+func (d *Database) UpdateFacilityRequirement(ctx context.Context, facilityID int64, requirementID int64, documentID int64, profession *domain.Profession, openAfter time.Time, dryRun bool) (*domain.RequirementChange, error) {
+	return d.changeFacilityRequirements(ctx, facilityID, openAfter, dryRun, func(tx pgx.Tx) (*domain.FacilityRequirement, error) {
+		if err := d.checkDocumentActive(ctx, tx, documentID); err != nil {
+			return nil, err
+		}
+
+		if err := d.checkDocumentNotRequired(ctx, tx, facilityID, documentID, profession, requirementID); err != nil {
+			return nil, err
+		}
+
+		sql, args, err := d.sq.
+			Update("\"FacilityRequirement\"").
+			Set("document_id", documentID).
+			Set("profession", professionArg(profession)).
+			Where("id = ?", requirementID).
+			Where("facility_id = ?", facilityID).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		tag, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return nil, domain.ErrRequirementNotFound
+		}
+
+		return d.getFacilityRequirement(ctx, tx, facilityID, requirementID)
+	})
+}
+
+// RemoveFacilityRequirement stops requiring the document of a requirement of
+// the facility. The removed requirement is returned.
+func (d *Database) RemoveFacilityRequirement(ctx context.Context, facilityID int64, requirementID int64, openAfter time.Time, dryRun bool) (*domain.RequirementChange, error) {
+	return d.changeFacilityRequirements(ctx, facilityID, openAfter, dryRun, func(tx pgx.Tx) (*domain.FacilityRequirement, error) {
+		// Read before it is deleted, as it can't be read back afterwards.
+		requirement, err := d.getFacilityRequirement(ctx, tx, facilityID, requirementID)
+		if err != nil {
+			return nil, err
+		}
+
+		sql, args, err := d.sq.
+			Delete("\"FacilityRequirement\"").
+			Where("id = ?", requirementID).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return nil, err
+		}
+
+		return requirement, nil
+	})
+}
+
+// changeFacilityRequirements applies a change to the requirements of the
+// facility in a transaction, measuring its impact on the eligibility of
+// workers for the facility's open shifts, those starting after openAfter. The
+// change returns the requirement it added, updated or removed. On dry runs,
+// the transaction is rolled back once the impact is measured.
+func (d *Database) changeFacilityRequirements(
+	ctx context.Context,
+	facilityID int64,
+	openAfter time.Time,
+	dryRun bool,
+	change func(tx pgx.Tx) (*domain.FacilityRequirement, error),
+) (*domain.RequirementChange, error) {
+	tx, err := d.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// A no-op once the transaction is committed.
+	defer tx.Rollback(ctx)
+
+	// Serialized with the facility's other requirement changes, so the
+	// measured impact is only that of this change.
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1, $2)", facilityLockNamespace, facilityID); err != nil {
+		return nil, err
+	}
+
+	if err := d.checkFacilityExists(ctx, tx, facilityID); err != nil {
+		return nil, err
+	}
+
+	before, err := d.getFacilityEligibleWorkers(ctx, tx, facilityID, openAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	requirement, err := change(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := d.getFacilityEligibleWorkers(ctx, tx, facilityID, openAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun {
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.RequirementChange{
+		Impact:      eligibilityImpact(before, after),
+		Requirement: *requirement,
+	}, nil
+}
+
+// getFacilityEligibleWorkers returns the profession of every worker eligible
+// for at least one open shift of the facility, by worker ID. Eligibility is
+// evaluated with the same rules as GetAvailableShifts, except for the overlap
+// with the shifts of workers, as requirements can't change its outcome.
+func (d *Database) getFacilityEligibleWorkers(ctx context.Context, tx pgx.Tx, facilityID int64, openAfter time.Time) (map[int64]domain.Profession, error) {
+	openShifts := d.sq.
+		Select("1").
+		From("\"Shift\" s").
+		InnerJoin("\"Facility\" f ON f.id = s.facility_id").
+		Where("f.id = ?", facilityID).
+		Where("s.start > ?", openAfter.UTC())
+
+	for _, rule := range d.eligibilityRules() {
+		if rule.rule == domain.EligibilityRuleNoOverlap {
+			continue
+		}
+
+		openShifts = openShifts.Where(rule.predicate)
+	}
+
+	sql, args, err := d.sq.
+		Select("w.id", "w.profession::text").
+		From("\"Worker\" w").
+		Where(squirrel.Expr("EXISTS (?)", openShifts.PlaceholderFormat(squirrel.Question))).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	workers := map[int64]domain.Profession{}
+	for rows.Next() {
+		var (
+			workerID   int64
+			profession domain.Profession
+		)
+
+		if err := rows.Scan(&workerID, &profession); err != nil {
+			return nil, err
+		}
+
+		workers[workerID] = profession
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return workers, nil
+}
+
+// eligibilityImpact counts the workers who are eligible after but not before,
+// and the other way around, by profession. Only professions with workers
+// eligible either before or after are listed, sorted by profession.
+func eligibilityImpact(before map[int64]domain.Profession, after map[int64]domain.Profession) []domain.EligibilityImpact {
+	impacts := map[domain.Profession]*domain.EligibilityImpact{}
+
+	impactOf := func(profession domain.Profession) *domain.EligibilityImpact {
+		if _, ok := impacts[profession]; !ok {
+			impacts[profession] = &domain.EligibilityImpact{Profession: profession}
+		}
+
+		return impacts[profession]
+	}
+
+	for workerID, profession := range before {
+		impact := impactOf(profession)
+		if _, ok := after[workerID]; !ok {
+			impact.Lost++
+		}
+	}
+
+	for workerID, profession := range after {
+		impact := impactOf(profession)
+		if _, ok := before[workerID]; !ok {
+			impact.Gained++
+		}
+	}
+
+	eligibilityImpacts := []domain.EligibilityImpact{}
+	for _, impact := range impacts {
+		eligibilityImpacts = append(eligibilityImpacts, *impact)
+	}
+
+	sort.Slice(eligibilityImpacts, func(i, j int) bool {
+		return eligibilityImpacts[i].Profession < eligibilityImpacts[j].Profession
+	})
+
+	return eligibilityImpacts
+}
+
+// CreateShift posts an open shift at an active facility.
+func (d *Database) CreateShift(ctx context.Context, facilityID int64, start time.Time, end time.Time, profession domain.Profession) (*domain.FacilityShift, error) {
+	var shift *domain.FacilityShift
+
+	err := pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		if err := d.checkFacilityActive(ctx, tx, facilityID); err != nil {
+			return err
+		}
+
+		sql, args, err := d.sq.
+			Insert("\"Shift\"").
+			Columns("facility_id", "start", "\"end\"", "profession").
+			Values(facilityID, start.UTC(), end.UTC(), string(profession)).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var shiftID int64
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&shiftID); err != nil {
+			return err
+		}
+
+		shift, err = d.getFacilityShift(ctx, tx, facilityID, shiftID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+// UpdateShift edits a shift of an active facility. A claimed shift is only
+// edited when unassign is set, in which case it is released and the
+// cancellation recorded against the facility, so the worker gets to know.
+func (d *Database) UpdateShift(ctx context.Context, facilityID int64, shiftID int64, update domain.ShiftUpdate, unassign bool) (*domain.FacilityShift, error) {
+	var shift *domain.FacilityShift
+
+	err := pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		if err := d.checkFacilityActive(ctx, tx, facilityID); err != nil {
+			return err
+		}
+
+		current, err := d.lockFacilityShift(ctx, tx, facilityID, shiftID)
+		if err != nil {
+			return err
+		}
+
+		start, end := current.start, current.end
+		if update.Start != nil {
+			start = update.Start.UTC()
+		}
+		if update.End != nil {
+			end = update.End.UTC()
+		}
+
+		if !end.After(start) {
+			return fmt.Errorf("%w: end must be after start", domain.ErrInvalidShift)
+		}
+
+		if err := d.unassignFacilityShift(ctx, tx, shiftID, current.claimedBy, unassign); err != nil {
+			return err
+		}
+
+		builder := d.sq.
+			Update("\"Shift\"").
+			Set("start", start).
+			Set("\"end\"", end).
+			Where("id = ?", shiftID)
+		if update.Profession != nil {
+			builder = builder.Set("profession", string(*update.Profession))
+		}
+
+		sql, args, err := builder.ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		shift, err = d.getFacilityShift(ctx, tx, facilityID, shiftID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+// DeleteShift soft deletes a shift of the facility, whether it is active or
+// not. A claimed shift is only deleted when unassign is set, in which case it
+// is released and the cancellation recorded against the facility.
+func (d *Database) DeleteShift(ctx context.Context, facilityID int64, shiftID int64, unassign bool) (*domain.FacilityShift, error) {
+	var shift *domain.FacilityShift
+
+	err := pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		if err := d.checkFacilityExists(ctx, tx, facilityID); err != nil {
+			return err
+		}
+
+		current, err := d.lockFacilityShift(ctx, tx, facilityID, shiftID)
+		if err != nil {
+			return err
+		}
+
+		if err := d.unassignFacilityShift(ctx, tx, shiftID, current.claimedBy, unassign); err != nil {
+			return err
+		}
+
+		sql, args, err := d.sq.
+			Update("\"Shift\"").
+			Set("is_deleted", true).
+			Where("id = ?", shiftID).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		shift, err = d.getFacilityShift(ctx, tx, facilityID, shiftID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+// checkFacilityActive returns domain.ErrFacilityNotFound when the facility
+// does not exist, or domain.ErrFacilityInactive when it is not active or is
+// suspended.
+func (d *Database) checkFacilityActive(ctx context.Context, q rowQuerier, facilityID int64) error {
+	sql, args, err := d.sq.
+		Select(activeFacility).
+		From("\"Facility\" f").
+		Where("f.id = ?", facilityID).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var isActive bool
+	if err := q.QueryRow(ctx, sql, args...).Scan(&isActive); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrFacilityNotFound
+		}
+
+		return err
+	}
+
+	if !isActive {
+		return domain.ErrFacilityInactive
+	}
+
+	return nil
+}
+
+type facilityShiftStatus struct {
+	claimedBy *int64
+	end       time.Time
+	start     time.Time
+}
+
+// lockFacilityShift locks a shift of the facility until the end of the
+// transaction, so it can't be claimed while being changed, or returns
+// domain.ErrShiftNotFound when the facility has no such shift.
+func (d *Database) lockFacilityShift(ctx context.Context, tx pgx.Tx, facilityID int64, shiftID int64) (*facilityShiftStatus, error) {
+	sql, args, err := d.sq.
+		Select("worker_id", "start", "\"end\"").
+		From("\"Shift\"").
+		Where("id = ?", shiftID).
+		Where("facility_id = ?", facilityID).
+		Where("is_deleted = FALSE").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &facilityShiftStatus{}
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&status.claimedBy, &status.start, &status.end); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrShiftNotFound
+		}
+
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// unassignFacilityShift releases a shift claimed by a worker when unassign is
+// set, recording the cancellation against the facility, or returns
+// domain.ErrShiftAlreadyClaimed otherwise. Open shifts are left as they are.
+func (d *Database) unassignFacilityShift(ctx context.Context, tx pgx.Tx, shiftID int64, claimedBy *int64, unassign bool) error {
+	if claimedBy == nil {
+		return nil
+	}
+
+	if !unassign {
+		return domain.ErrShiftAlreadyClaimed
+	}
+
+	sql, args, err := d.sq.
+		Update("\"Shift\"").
+		Set("worker_id", nil).
+		Where("id = ?", shiftID).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return d.recordShiftCancellation(ctx, tx, shiftID, *claimedBy, cancelledByFacility)
+}
+
+// getFacilityShift returns a shift of the facility, deleted or not, or
+// domain.ErrShiftNotFound when the facility has no such shift.
+func (d *Database) getFacilityShift(ctx context.Context, tx pgx.Tx, facilityID int64, shiftID int64) (*domain.FacilityShift, error) {
+	sql, args, err := d.facilityShifts().
+		Where("s.id = ?", shiftID).
+		Where("s.facility_id = ?", facilityID).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	shifts, err := deserializeFacilityShifts(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shifts) == 0 {
+		return nil, domain.ErrShiftNotFound
+	}
+
+	return &shifts[0], nil
+}
+
+// facilityShifts selects from shifts, aliased as s, along with their facility
+// (f).
+func (d *Database) facilityShifts() squirrel.SelectBuilder {
+	return d.sq.
+		Select(
+			"f.id",
+			"f.name",
+			"f.time_zone",
+			"s.id",
+			"s.start",
+			"s.end",
+			"s.profession::text",
+			"s.worker_id",
+		).
+		From("\"Shift\" s").
+		InnerJoin("\"Facility\" f ON f.id = s.facility_id")
+}
+
+func deserializeFacilityShifts(rows pgx.Rows) ([]domain.FacilityShift, error) {
+	defer rows.Close()
+
+	shifts := []domain.FacilityShift{}
+	for rows.Next() {
+		fs := domain.FacilityShift{}
+
+		var timeZone string
+
+		if err := rows.Scan(
+			&fs.Shift.Facility.ID,
+			&fs.Shift.Facility.Name,
+			&timeZone,
+			&fs.Shift.ID,
+			&fs.Shift.Start,
+			&fs.Shift.End,
+			&fs.Profession,
+			&fs.WorkerID,
+		); err != nil {
+			return nil, err
+		}
+
+		loc, err := loadLocation(timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("facility %d: %w", fs.Shift.Facility.ID, err)
+		}
+
+		fs.Shift.Facility.TimeZone = loc
+		fs.Shift.Start = fs.Shift.Start.In(loc)
+		fs.Shift.End = fs.Shift.End.In(loc)
+
+		shifts = append(shifts, fs)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return shifts, nil
+}
+
+// checkFacilityExists returns domain.ErrFacilityNotFound when the facility
+// does not exist.
+func (d *Database) checkFacilityExists(ctx context.Context, q rowQuerier, facilityID int64) error {
+	sql, args, err := d.sq.
+		Select("1").
+		From("\"Facility\"").
+		Where("id = ?", facilityID).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var found int
+	if err := q.QueryRow(ctx, sql, args...).Scan(&found); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrFacilityNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// checkDocumentNotRequired returns domain.ErrRequirementAlreadyExists when
+// the document is already required by the facility for the same profession, by
+// a requirement other than exceptRequirementID.
+func (d *Database) checkDocumentNotRequired(ctx context.Context, q rowQuerier, facilityID int64, documentID int64, profession *domain.Profession, exceptRequirementID int64) error {
+	sql, args, err := d.sq.
+		Select("1").
+		From("\"FacilityRequirement\"").
+		Where("facility_id = ?", facilityID).
+		Where("document_id = ?", documentID).
+		Where("profession::text IS NOT DISTINCT FROM ?", professionArg(profession)).
+		Where("id <> ?", exceptRequirementID).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var found int
+	if err := q.QueryRow(ctx, sql, args...).Scan(&found); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	return domain.ErrRequirementAlreadyExists
+}
+
+// getFacilityRequirement returns a requirement of the facility, or
+// domain.ErrRequirementNotFound when the facility has no such requirement.
+func (d *Database) getFacilityRequirement(ctx context.Context, tx pgx.Tx, facilityID int64, requirementID int64) (*domain.FacilityRequirement, error) {
+	sql, args, err := d.facilityRequirementsWithDocument().
+		Where("fr.id = ?", requirementID).
+		Where("fr.facility_id = ?", facilityID).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements, err := deserializeFacilityRequirements(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(requirements) == 0 {
+		return nil, domain.ErrRequirementNotFound
+	}
+
+	return &requirements[0], nil
+}
+
+// facilityRequirementsWithDocument selects from the requirements of
+// facilities, aliased as fr, along with the document of the catalogue (doc)
+// they require.
+func (d *Database) facilityRequirementsWithDocument() squirrel.SelectBuilder {
+	return d.sq.
+		Select(
+			"fr.id",
+			"fr.facility_id",
+			"fr.profession::text",
+			"doc.id",
+			"doc.name",
+			"doc.is_active",
+		).
+		From("\"FacilityRequirement\" fr").
+		InnerJoin("\"Document\" doc ON doc.id = fr.document_id")
+}
+
+// professionArg passes an optional profession as a query argument, as NULL
+// when there is none.
+func professionArg(profession *domain.Profession) interface{} {
+	if profession == nil {
+		return nil
+	}
+
+	return string(*profession)
+}
+
+func deserializeFacilityRequirements(rows pgx.Rows) ([]domain.FacilityRequirement, error) {
+	defer rows.Close()
+
+	requirements := []domain.FacilityRequirement{}
+	for rows.Next() {
+		fr := domain.FacilityRequirement{}
+
+		if err := rows.Scan(
+			&fr.ID,
+			&fr.FacilityID,
+			&fr.Profession,
+			&fr.Document.ID,
+			&fr.Document.Name,
+			&fr.Document.IsActive,
+		); err != nil {
+			return nil, err
+		}
+
+		requirements = append(requirements, fr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return requirements, nil
+}
@@ -0,0 +1,311 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetFacilityStatusChanges returns the audit trail of the status of the
+// facility, oldest first.
+func (d *Database) GetFacilityStatusChanges(ctx context.Context, facilityID int64) ([]domain.FacilityStatusChange, error) {
+	if err := d.checkFacilityExists(ctx, d.Pool, facilityID); err != nil {
+		return nil, err
+	}
+
+	sql, args, err := d.facilityStatusChanges().
+		Where("facility_id = ?", facilityID).
+		OrderBy("changed_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return deserializeFacilityStatusChanges(rows)
+}
+
+// ChangeFacilityStatus activates, deactivates or suspends the facility until
+// the given time, recording the change in its audit trail. Activating a
+// facility lifts any suspension.
+//
+// The workers holding claims on the shifts the change hides, that is the ones
+// starting after the given time and, when suspending, before the suspension
+// ends, are reported as affected. Those claims are cancelled on behalf of the
+// facility when cancelClaims is set, and kept otherwise, so they show up again
+// once the facility is active.
+func (d *Database) ChangeFacilityStatus(ctx context.Context, facilityID int64, action domain.FacilityAction, suspendedUntil *time.Time, cancelClaims bool, after time.Time) (*domain.FacilityLifecycleChange, error) {
+	var change *domain.FacilityLifecycleChange
+
+	err := pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		// Serialized with the facility's other changes, so the audit trail
+		// follows the order they were applied in.
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1, $2)", facilityLockNamespace, facilityID); err != nil {
+			return err
+		}
+
+		status, err := d.updateFacilityStatus(ctx, tx, facilityID, action, suspendedUntil)
+		if err != nil {
+			return err
+		}
+
+		change = &domain.FacilityLifecycleChange{
+			AffectedWorkers: []domain.AffectedWorker{},
+			Status:          *status,
+		}
+
+		if action != domain.FacilityActionActivate {
+			claims, err := d.lockFacilityClaims(ctx, tx, facilityID, after, suspendedUntil)
+			if err != nil {
+				return err
+			}
+
+			if cancelClaims {
+				if err := d.cancelFacilityClaims(ctx, tx, claims); err != nil {
+					return err
+				}
+			}
+
+			change.AffectedWorkers = affectedWorkers(claims)
+		}
+
+		sql, args, err := d.sq.
+			Insert("\"FacilityStatusChange\"").
+			Columns("facility_id", "action", "suspended_until", "cancelled_claims").
+			Values(facilityID, string(action), timeArg(suspendedUntil), cancelClaims).
+			Suffix("RETURNING id, facility_id, action::text, suspended_until, cancelled_claims, changed_at").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		changes, err := deserializeFacilityStatusChanges(rows)
+		if err != nil {
+			return err
+		}
+
+		change.Change = changes[0]
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// updateFacilityStatus applies the action to the facility, returning its new
+// status, or domain.ErrFacilityNotFound when it does not exist.
+func (d *Database) updateFacilityStatus(ctx context.Context, tx pgx.Tx, facilityID int64, action domain.FacilityAction, suspendedUntil *time.Time) (*domain.FacilityStatus, error) {
+	updateBuilder := d.sq.
+		Update("\"Facility\"").
+		Where("id = ?", facilityID).
+		Suffix("RETURNING is_active, suspended_until")
+
+	switch action {
+	case domain.FacilityActionActivate:
+		updateBuilder = updateBuilder.
+			Set("is_active", true).
+			Set("suspended_until", nil)
+	case domain.FacilityActionDeactivate:
+		updateBuilder = updateBuilder.
+			Set("is_active", false).
+			Set("suspended_until", nil)
+	case domain.FacilityActionSuspend:
+		updateBuilder = updateBuilder.
+			Set("is_active", true).
+			Set("suspended_until", timeArg(suspendedUntil))
+	default:
+		return nil, errors.New("invalid facility action")
+	}
+
+	sql, args, err := updateBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &domain.FacilityStatus{}
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&status.IsActive, &status.SuspendedUntil); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrFacilityNotFound
+		}
+
+		return nil, err
+	}
+
+	return status, nil
+}
+
+type facilityClaim struct {
+	shiftID  int64
+	workerID int64
+}
+
+// lockFacilityClaims locks the claimed shifts of the facility starting after
+// the given time and, when set, before the given end, returning who claimed
+// them.
+func (d *Database) lockFacilityClaims(ctx context.Context, tx pgx.Tx, facilityID int64, after time.Time, before *time.Time) ([]facilityClaim, error) {
+	sqlBuilder := d.sq.
+		Select("id", "worker_id").
+		From("\"Shift\"").
+		Where("facility_id = ?", facilityID).
+		Where("worker_id IS NOT NULL").
+		Where("is_deleted = FALSE").
+		Where("start > ?", after.UTC()).
+		OrderBy("worker_id", "start", "id").
+		Suffix("FOR UPDATE")
+
+	if before != nil {
+		sqlBuilder = sqlBuilder.Where("start < ?", before.UTC())
+	}
+
+	sql, args, err := sqlBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var claims []facilityClaim
+	for rows.Next() {
+		var c facilityClaim
+
+		if err := rows.Scan(&c.shiftID, &c.workerID); err != nil {
+			return nil, err
+		}
+
+		claims = append(claims, c)
+	}
+
+	return claims, rows.Err()
+}
+
+// cancelFacilityClaims releases the claimed shifts, recording each release as
+// a cancellation by the facility.
+func (d *Database) cancelFacilityClaims(ctx context.Context, tx pgx.Tx, claims []facilityClaim) error {
+	if len(claims) == 0 {
+		return nil
+	}
+
+	shiftIDs := make([]int64, 0, len(claims))
+	for _, c := range claims {
+		shiftIDs = append(shiftIDs, c.shiftID)
+	}
+
+	sql, args, err := d.sq.
+		Update("\"Shift\"").
+		Set("worker_id", nil).
+		Where("id = ANY(?)", shiftIDs).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	for _, c := range claims {
+		if err := d.recordShiftCancellation(ctx, tx, c.shiftID, c.workerID, cancelledByFacility); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// affectedWorkers groups the claims by the worker who holds them, ordered by
+// worker ID.
+func affectedWorkers(claims []facilityClaim) []domain.AffectedWorker {
+	byWorker := map[int64][]int64{}
+	for _, c := range claims {
+		byWorker[c.workerID] = append(byWorker[c.workerID], c.shiftID)
+	}
+
+	affected := make([]domain.AffectedWorker, 0, len(byWorker))
+	for workerID, shiftIDs := range byWorker {
+		affected = append(affected, domain.AffectedWorker{
+			ShiftIDs: shiftIDs,
+			WorkerID: workerID,
+		})
+	}
+
+	sort.Slice(affected, func(i, j int) bool {
+		return affected[i].WorkerID < affected[j].WorkerID
+	})
+
+	return affected
+}
+
+// facilityStatusChanges selects from the audit trail of the status of
+// facilities.
+func (d *Database) facilityStatusChanges() squirrel.SelectBuilder {
+	return d.sq.
+		Select(
+			"id",
+			"facility_id",
+			"action::text",
+			"suspended_until",
+			"cancelled_claims",
+			"changed_at",
+		).
+		From("\"FacilityStatusChange\"")
+}
+
+// timeArg returns the time in UTC, as timestamps are stored, or nil when unset.
+func timeArg(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+
+	return t.UTC()
+}
+
+func deserializeFacilityStatusChanges(rows pgx.Rows) ([]domain.FacilityStatusChange, error) {
+	defer rows.Close()
+
+	changes := []domain.FacilityStatusChange{}
+	for rows.Next() {
+		c := domain.FacilityStatusChange{}
+
+		if err := rows.Scan(
+			&c.ID,
+			&c.FacilityID,
+			&c.Action,
+			&c.SuspendedUntil,
+			&c.CancelledClaims,
+			&c.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,228 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/config"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// changesChannel is the channel the notify_change trigger function notifies
+// changes on.
+const changesChannel = "changes"
+
+// Listener listens to the changes notified by the database, dispatching them
+// to its handlers as domain events.
+type Listener struct {
+	connConfig *pgx.ConnConfig
+	handlers   []domain.ChangeHandler
+	logger     *zap.Logger
+	maxBackoff time.Duration
+	minBackoff time.Duration
+	mu         sync.RWMutex
+}
+
+func NewListener(cfg config.Database, logger *zap.Logger) (*Listener, error) {
+	if cfg.ListenMinBackoff <= 0 {
+		return nil, errors.New("listen min backoff must be positive")
+	}
+
+	if cfg.ListenMinBackoff > cfg.ListenMaxBackoff {
+		return nil, errors.New("listen min backoff must not be greater than the max backoff")
+	}
+
+	// Parsed as a pool configuration, as the DSN may hold pool parameters
+	// which a single connection would send to the server.
+	c, err := pgxpool.ParseConfig(cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Listener{
+		connConfig: c.ConnConfig,
+		logger:     logger,
+		maxBackoff: cfg.ListenMaxBackoff,
+		minBackoff: cfg.ListenMinBackoff,
+	}, nil
+}
+
+// Handle registers a handler to be called with every change from then on.
+func (l *Listener) Handle(handler domain.ChangeHandler) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.handlers = append(l.handlers, handler)
+}
+
+// Run listens to changes until the context is done, returning its error.
+// Whenever the connection is lost it reconnects, waiting twice as long
+// between attempts each time they fail, and dispatches domain.ChangesMissed
+// once connected again, as changes made meanwhile were not notified.
+func (l *Listener) Run(ctx context.Context) error {
+	backoff := l.minBackoff
+	connectedBefore := false
+
+	for {
+		err := l.listen(ctx, func() {
+			if connectedBefore {
+				l.dispatch(ctx, domain.ChangesMissed{})
+			}
+
+			connectedBefore = true
+			backoff = l.minBackoff
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		l.logger.Warn("postgres: lost connection listening to changes",
+			zap.Error(err),
+			zap.Duration("retry_in", backoff),
+		)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > l.maxBackoff {
+			backoff = l.maxBackoff
+		}
+	}
+}
+
+// listen connects to the database and dispatches the changes notified until
+// the connection fails, calling connected once listening.
+func (l *Listener) listen(ctx context.Context, connected func()) error {
+	conn, err := pgx.ConnectConfig(ctx, l.connConfig)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+changesChannel); err != nil {
+		return err
+	}
+
+	connected()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		event, err := decodeChange(notification.Payload)
+		if err != nil {
+			l.logger.Error("postgres: failed to decode change",
+				zap.Error(err),
+				zap.String("payload", notification.Payload),
+			)
+
+			continue
+		}
+
+		l.dispatch(ctx, event)
+	}
+}
+
+func (l *Listener) dispatch(ctx context.Context, event domain.ChangeEvent) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for _, handler := range l.handlers {
+		handler(ctx, event)
+	}
+}
+
+// change is the payload notified by the notify_change trigger function. Old
+// is only set on updates.
+type change struct {
+	Old       *changedRow            `json:"old"`
+	Operation domain.ChangeOperation `json:"operation"`
+	Row       changedRow             `json:"row"`
+	Table     string                 `json:"table"`
+}
+
+// changedRow holds the columns of the changed tables events are made of.
+type changedRow struct {
+	DocumentID int64  `json:"document_id"`
+	FacilityID int64  `json:"facility_id"`
+	ID         int64  `json:"id"`
+	WorkerID   *int64 `json:"worker_id"`
+}
+
+func decodeChange(payload string) (domain.ChangeEvent, error) {
+	var c change
+	if err := json.Unmarshal([]byte(payload), &c); err != nil {
+		return nil, err
+	}
+
+	switch c.Operation {
+	case domain.ChangeOperationDelete, domain.ChangeOperationInsert, domain.ChangeOperationUpdate:
+	default:
+		return nil, fmt.Errorf("unknown change operation %q", c.Operation)
+	}
+
+	switch c.Table {
+	case "Document":
+		return domain.DocumentChanged{
+			DocumentID: c.Row.ID,
+			Operation:  c.Operation,
+		}, nil
+	case "DocumentWorker":
+		if c.Row.WorkerID == nil {
+			return nil, fmt.Errorf("change of %q has no worker_id", c.Table)
+		}
+
+		return domain.WorkerDocumentChanged{
+			DocumentID: c.Row.DocumentID,
+			Operation:  c.Operation,
+			WorkerID:   *c.Row.WorkerID,
+		}, nil
+	case "Facility":
+		return domain.FacilityChanged{
+			FacilityID: c.Row.ID,
+			Operation:  c.Operation,
+		}, nil
+	case "FacilityRequirement":
+		return domain.FacilityRequirementChanged{
+			DocumentID: c.Row.DocumentID,
+			FacilityID: c.Row.FacilityID,
+			Operation:  c.Operation,
+		}, nil
+	case "Shift":
+		event := domain.ShiftChanged{
+			FacilityID: c.Row.FacilityID,
+			Operation:  c.Operation,
+			ShiftID:    c.Row.ID,
+			WorkerID:   c.Row.WorkerID,
+		}
+
+		if c.Old != nil {
+			event.PreviousWorkerID = c.Old.WorkerID
+		}
+
+		return event, nil
+	case "Worker":
+		return domain.WorkerChanged{
+			Operation: c.Operation,
+			WorkerID:  c.Row.ID,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown changed table %q", c.Table)
+	}
+}
@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetEligibleWorkers returns the workers eligible for the shift, ordered by
+// ID. They are evaluated with the same rules as GetAvailableShifts, so a
+// worker is listed here exactly when the shift is available to them.
+func (d *Database) GetEligibleWorkers(ctx context.Context, queryCursor *cursor.Cursor, limit int, shiftID int64) ([]domain.Worker, error) {
+	if _, err := d.getShiftStatus(ctx, d.Pool, shiftID); err != nil {
+		return nil, err
+	}
+
+	sqlBuilder := d.eligibleWorkers(shiftID).
+		Columns(workerColumns...).
+		Limit(uint64(limit))
+
+	for _, cte := range d.shiftEligibilityCTEs(shiftID) {
+		sqlBuilder = sqlBuilder.PrefixExpr(cte)
+	}
+
+	switch {
+	case queryCursor == nil:
+		sqlBuilder = sqlBuilder.OrderBy("w.id ASC")
+	case queryCursor.Direction == cursor.DirectionBefore:
+		workerID, err := workerCursorKey(queryCursor)
+		if err != nil {
+			return nil, err
+		}
+
+		sqlBuilder = sqlBuilder.
+			Where("w.id < ?", workerID).
+			OrderBy("w.id DESC")
+	case queryCursor.Direction == cursor.DirectionAfter:
+		workerID, err := workerCursorKey(queryCursor)
+		if err != nil {
+			return nil, err
+		}
+
+		sqlBuilder = sqlBuilder.
+			Where("w.id > ?", workerID).
+			OrderBy("w.id ASC")
+	default:
+		return nil, errors.New("invalid queryCursor direction")
+	}
+
+	sql, args, err := sqlBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	workers, err := deserializeWorkers(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if queryCursor != nil && queryCursor.Direction == cursor.DirectionBefore {
+		// Workers before the cursor are fetched nearest first, but are always
+		// returned in ascending order.
+		for i, j := 0, len(workers)-1; i < j; i, j = i+1, j-1 {
+			workers[i], workers[j] = workers[j], workers[i]
+		}
+	}
+
+	return workers, nil
+}
+
+// workerCursorKey parses the worker ID a cursor over workers points at.
+func workerCursorKey(queryCursor *cursor.Cursor) (int64, error) {
+	if len(queryCursor.Keys) != 1 {
+		return 0, fmt.Errorf("%w: expected 1 key, got %d", domain.ErrInvalidCursor, len(queryCursor.Keys))
+	}
+
+	workerID, err := strconv.ParseInt(queryCursor.Keys[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", domain.ErrInvalidCursor, err)
+	}
+
+	return workerID, nil
+}
+
+// workerColumns are the columns of the workers, aliased as w, deserializeWorkers
+// expects.
+var workerColumns = []string{
+	"w.id",
+	"w.name",
+	"w.profession::text",
+	"w.is_active",
+}
+
+// workers selects from every worker, aliased as w.
+func (d *Database) workers() squirrel.SelectBuilder {
+	return d.sq.
+		Select(workerColumns...).
+		From("\"Worker\" w")
+}
+
+// eligibleWorkers selects from the workers eligible for the given shift,
+// aliased as w, along with the shift (s) and its facility (f). It relies on
+// the CTEs built by shiftEligibilityCTEs.
+func (d *Database) eligibleWorkers(shiftID int64) squirrel.SelectBuilder {
+	builder := d.workersForShift(shiftID)
+
+	for _, rule := range d.eligibilityRules() {
+		builder = builder.Where(rule.predicate)
+	}
+
+	return builder
+}
+
+// workersForShift selects from every worker, aliased as w, joined with the
+// given shift (s) and its facility (f), so eligibility rules can be evaluated
+// over them.
+func (d *Database) workersForShift(shiftID int64) squirrel.SelectBuilder {
+	return d.sq.
+		Select().
+		From("\"Worker\" w").
+		InnerJoin("\"Shift\" s ON s.id = ?", shiftID).
+		InnerJoin("\"Facility\" f ON f.id = s.facility_id")
+}
+
+// shiftEligibilityCTEs returns the CTEs eligibleWorkers relies on, to be
+// prefixed in order. They hold the shifts of every worker, narrowed down to
+// the ones that could overlap the given shift.
+func (d *Database) shiftEligibilityCTEs(shiftID int64) []squirrel.Sqlizer {
+	minRestPeriod := d.minRestPeriod.Seconds()
+
+	return []squirrel.Sqlizer{
+		d.claimedShifts().
+			Where(
+				"start < (SELECT \"end\" FROM \"Shift\" WHERE id = ?) + MAKE_INTERVAL(secs => ?)",
+				shiftID,
+				minRestPeriod,
+			).
+			Where(
+				"(SELECT start FROM \"Shift\" WHERE id = ?) < \"end\" + MAKE_INTERVAL(secs => ?)",
+				shiftID,
+				minRestPeriod,
+			).
+			Prefix("WITH worker_shifts AS MATERIALIZED (").Suffix(")"),
+	}
+}
+
+func deserializeWorkers(rows pgx.Rows) ([]domain.Worker, error) {
+	defer rows.Close()
+
+	workers := []domain.Worker{}
+	for rows.Next() {
+		w := domain.Worker{}
+
+		if err := rows.Scan(
+			&w.ID,
+			&w.Name,
+			&w.Profession,
+			&w.IsActive,
+		); err != nil {
+			return nil, err
+		}
+
+		workers = append(workers, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return workers, nil
+}
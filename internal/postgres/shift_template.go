@@ -0,0 +1,324 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"cloud.google.com/go/civil"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GetShiftTemplates returns the shift templates of the facility.
+func (d *Database) GetShiftTemplates(ctx context.Context, facilityID int64) ([]domain.ShiftTemplate, error) {
+	if err := d.checkFacilityExists(ctx, d.Pool, facilityID); err != nil {
+		return nil, err
+	}
+
+	sql, args, err := d.shiftTemplates().
+		Where("facility_id = ?", facilityID).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return deserializeShiftTemplates(rows)
+}
+
+// CreateShiftTemplate adds a shift template to an active facility.
+func (d *Database) CreateShiftTemplate(ctx context.Context, template domain.ShiftTemplate) (*domain.ShiftTemplate, error) {
+	var created *domain.ShiftTemplate
+
+	err := pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		if err := d.checkFacilityActive(ctx, tx, template.FacilityID); err != nil {
+			return err
+		}
+
+		daysOfWeek := make([]int32, 0, len(template.DaysOfWeek))
+		for _, day := range template.DaysOfWeek {
+			daysOfWeek = append(daysOfWeek, int32(day))
+		}
+
+		var startsOn interface{}
+		if template.StartsOn != nil {
+			startsOn = template.StartsOn.In(time.UTC)
+		}
+
+		sql, args, err := d.sq.
+			Insert("\"ShiftTemplate\"").
+			Columns("facility_id", "profession", "days_of_week", "start_time", "duration_minutes", "headcount", "count", "starts_on").
+			Values(
+				template.FacilityID,
+				string(template.Profession),
+				daysOfWeek,
+				pgtype.Time{Microseconds: timeOfDayMicroseconds(template.StartTime), Valid: true},
+				int(template.Duration/time.Minute),
+				template.Headcount,
+				template.Count,
+				startsOn,
+			).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var templateID int64
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&templateID); err != nil {
+			return err
+		}
+
+		created, err = d.getShiftTemplate(ctx, tx, template.FacilityID, templateID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// MaterializeShiftTemplate posts the shifts of a template of an active
+// facility which start on the dates between start and end, both inclusive and
+// in the time zone of the facility, and after the given time. Shifts already
+// materialized from the template are left as they are, even when they were
+// edited or deleted since, so materializing a template over the same dates
+// again does not post them twice.
+func (d *Database) MaterializeShiftTemplate(ctx context.Context, facilityID int64, templateID int64, start civil.Date, end civil.Date, after time.Time) (*domain.ShiftMaterialization, error) {
+	var materialization *domain.ShiftMaterialization
+
+	err := pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		if err := d.checkFacilityActive(ctx, tx, facilityID); err != nil {
+			return err
+		}
+
+		template, err := d.getShiftTemplate(ctx, tx, facilityID, templateID)
+		if err != nil {
+			return err
+		}
+
+		loc, err := d.getFacilityLocation(ctx, tx, facilityID)
+		if err != nil {
+			return err
+		}
+
+		builder := d.sq.
+			Insert("\"Shift\"").
+			Columns("facility_id", "start", "\"end\"", "profession", "template_id", "template_occurrence", "template_slot").
+			Suffix("ON CONFLICT (template_id, template_occurrence, template_slot) DO NOTHING RETURNING id")
+
+		var shifts int
+		for _, occurrence := range template.Occurrences(start, end, loc) {
+			if !occurrence.After(after) {
+				continue
+			}
+
+			for slot := 1; slot <= template.Headcount; slot++ {
+				builder = builder.Values(
+					facilityID,
+					occurrence.UTC(),
+					occurrence.Add(template.Duration).UTC(),
+					string(template.Profession),
+					templateID,
+					occurrence.UTC(),
+					slot,
+				)
+				shifts++
+			}
+		}
+
+		materialization = &domain.ShiftMaterialization{
+			Created: []domain.FacilityShift{},
+		}
+
+		if shifts == 0 {
+			return nil
+		}
+
+		sql, args, err := builder.ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		shiftIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+		if err != nil {
+			return err
+		}
+
+		if len(shiftIDs) > 0 {
+			sql, args, err = d.facilityShifts().
+				Where("s.id = ANY(?)", shiftIDs).
+				OrderBy("s.start", "s.id").
+				ToSql()
+			if err != nil {
+				return err
+			}
+
+			rows, err = tx.Query(ctx, sql, args...)
+			if err != nil {
+				return err
+			}
+
+			materialization.Created, err = deserializeFacilityShifts(rows)
+			if err != nil {
+				return err
+			}
+		}
+
+		materialization.Existing = shifts - len(shiftIDs)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return materialization, nil
+}
+
+// getShiftTemplate returns a shift template of the facility, or
+// domain.ErrShiftTemplateNotFound when the facility has no such template.
+func (d *Database) getShiftTemplate(ctx context.Context, tx pgx.Tx, facilityID int64, templateID int64) (*domain.ShiftTemplate, error) {
+	sql, args, err := d.shiftTemplates().
+		Where("id = ?", templateID).
+		Where("facility_id = ?", facilityID).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := deserializeShiftTemplates(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(templates) == 0 {
+		return nil, domain.ErrShiftTemplateNotFound
+	}
+
+	return &templates[0], nil
+}
+
+// getFacilityLocation returns the time zone of the facility, or
+// domain.ErrFacilityNotFound when it does not exist.
+func (d *Database) getFacilityLocation(ctx context.Context, q rowQuerier, facilityID int64) (*time.Location, error) {
+	sql, args, err := d.sq.
+		Select("time_zone").
+		From("\"Facility\"").
+		Where("id = ?", facilityID).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var timeZone string
+	if err := q.QueryRow(ctx, sql, args...).Scan(&timeZone); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrFacilityNotFound
+		}
+
+		return nil, err
+	}
+
+	return loadLocation(timeZone)
+}
+
+// shiftTemplates selects from the shift templates of facilities.
+func (d *Database) shiftTemplates() squirrel.SelectBuilder {
+	return d.sq.
+		Select(
+			"id",
+			"facility_id",
+			"profession::text",
+			"days_of_week",
+			"start_time",
+			"duration_minutes",
+			"headcount",
+			"count",
+			"starts_on",
+		).
+		From("\"ShiftTemplate\"")
+}
+
+// timeOfDayMicroseconds returns the microseconds since midnight of a time of
+// day, as TIME columns are stored.
+func timeOfDayMicroseconds(t civil.Time) int64 {
+	sinceMidnight := time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.Nanosecond)
+
+	return sinceMidnight.Microseconds()
+}
+
+func deserializeShiftTemplates(rows pgx.Rows) ([]domain.ShiftTemplate, error) {
+	defer rows.Close()
+
+	templates := []domain.ShiftTemplate{}
+	for rows.Next() {
+		st := domain.ShiftTemplate{}
+
+		var (
+			daysOfWeek      []int32
+			startTime       pgtype.Time
+			durationMinutes int
+			startsOn        pgtype.Date
+		)
+
+		if err := rows.Scan(
+			&st.ID,
+			&st.FacilityID,
+			&st.Profession,
+			&daysOfWeek,
+			&startTime,
+			&durationMinutes,
+			&st.Headcount,
+			&st.Count,
+			&startsOn,
+		); err != nil {
+			return nil, err
+		}
+
+		for _, day := range daysOfWeek {
+			st.DaysOfWeek = append(st.DaysOfWeek, time.Weekday(day))
+		}
+
+		sinceMidnight := time.Duration(startTime.Microseconds) * time.Microsecond
+		st.StartTime = civil.TimeOf(time.Time{}.Add(sinceMidnight))
+		st.Duration = time.Duration(durationMinutes) * time.Minute
+
+		if startsOn.Valid {
+			date := civil.DateOf(startsOn.Time)
+			st.StartsOn = &date
+		}
+
+		templates = append(templates, st)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
@@ -3,86 +3,463 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/rodrigosdo/facilities-api/internal/cursor"
 	"github.com/rodrigosdo/facilities-api/internal/domain"
 
-	"cloud.google.com/go/civil"
+	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
 )
 
-func (d *Database) GetAvailableShifts(ctx context.Context, queryCursor *cursor.Cursor, limit int, workerID int64, start civil.Date, end civil.Date) (domain.Shifts, error) {
-	shiftsBuilder := d.sq.
-		Select(
+// workerLockNamespace is the first key of the advisory locks taken on workers.
+const workerLockNamespace = 1
+
+func (d *Database) GetAvailableShifts(ctx context.Context, queryCursor *cursor.Cursor, limit int, workerID int64, filter domain.ShiftFilter, sort domain.ShiftSort) (domain.Shifts, error) {
+	sql, args, err := d.availableShiftsSQL(queryCursor, limit, workerID, filter, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	shifts, err := deserializeWorkerAvailableShifts(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if queryCursor != nil && queryCursor.Direction == cursor.DirectionBefore {
+		// Shifts before the cursor are fetched nearest first, but are always
+		// returned in ascending order.
+		for i, j := 0, len(shifts)-1; i < j; i, j = i+1, j-1 {
+			shifts[i], shifts[j] = shifts[j], shifts[i]
+		}
+	}
+
+	return shifts, nil
+}
+
+// availableShiftsSQL builds the query of GetAvailableShifts.
+func (d *Database) availableShiftsSQL(queryCursor *cursor.Cursor, limit int, workerID int64, filter domain.ShiftFilter, sort domain.ShiftSort) (string, []interface{}, error) {
+	shiftsBuilder := d.eligibleShifts(workerID).
+		Columns(
 			"s.start AS rounded_start",
 			"s.end AS rounded_end",
 			"s.id AS shift_id",
 			"f.id AS facility_id",
 			"f.name AS facility_name",
-		).
-		From("\"Shift\" s").
-		InnerJoin("\"Facility\" f ON f.id = s.facility_id").
-		InnerJoin("\"Worker\" w ON w.profession = s.profession").
-		InnerJoin("facility_documents fd ON fd.facility_id = f.id").
-		InnerJoin("worker_documents wd ON wd.worker_id = w.id AND wd.worker_documents @> fd.required_documents").
-		Where("f.is_active = TRUE").
-		Where("s.is_deleted = FALSE").
-		Where("w.is_active = TRUE").
-		Where("s.worker_id IS NULL").
-		Where("w.id = ?", workerID).
-		OrderBy("rounded_start", "rounded_end", "facility_id")
+			"f.time_zone AS facility_time_zone",
+		)
 
-	if !start.IsZero() && !end.IsZero() {
-		shiftsBuilder = shiftsBuilder.
-			Where("DATE_TRUNC('day', s.start) BETWEEN ? AND ?", start, end).
-			Where("DATE_TRUNC('day', s.end) BETWEEN ? AND ?", start, end)
+	if predicates := shiftFilterPredicates(filter); len(predicates) > 0 {
+		shiftsBuilder = shiftsBuilder.Where(predicates)
 	}
 
 	sqlBuilder := d.sq.
 		Select(
 			"facility_id",
 			"facility_name",
+			"facility_time_zone",
 			"shift_id",
 			"rounded_start",
 			"rounded_end",
-		).
-		PrefixExpr(
-			d.sq.Select(
-				"facility_id",
-				"ARRAY_AGG(document_id) AS required_documents",
-			).
-				From("\"FacilityRequirement\"").
-				GroupBy("facility_id").
-				Prefix("WITH facility_documents AS (").Suffix("),"),
-		).
-		PrefixExpr(
-			d.sq.Select(
-				"worker_id",
-				"ARRAY_AGG(document_id) AS worker_documents",
-			).
-				From("\"DocumentWorker\"").
-				Where("worker_id = ?", workerID).
-				GroupBy("worker_id").
-				Prefix("worker_documents AS (").Suffix("),"),
-		).
+		)
+
+	for _, cte := range d.eligibilityCTEs(workerID) {
+		sqlBuilder = sqlBuilder.PrefixExpr(cte)
+	}
+
+	sqlBuilder = sqlBuilder.
 		PrefixExpr(
-			shiftsBuilder.Prefix("rounded_shifts AS (").Suffix(")"),
+			shiftsBuilder.Prefix(", rounded_shifts AS (").Suffix(")"),
 		).
 		From("rounded_shifts").
 		Limit(uint64(limit))
 
+	sortColumns, err := shiftSortColumns(sort)
+	if err != nil {
+		return "", nil, err
+	}
+
+	keyset := "(" + strings.Join(sortColumns, ", ") + ")"
+
 	switch {
 	case queryCursor == nil:
-		sqlBuilder = sqlBuilder.OrderBy("shift_id ASC")
+		sqlBuilder = sqlBuilder.OrderBy(orderBy(sortColumns, "ASC")...)
 	case queryCursor.Direction == cursor.DirectionBefore:
-		sqlBuilder = sqlBuilder.Where("shift_id < ? ", queryCursor.Reference).OrderBy("shift_id DESC")
+		keys, err := shiftCursorKeys(queryCursor, sort)
+		if err != nil {
+			return "", nil, err
+		}
+
+		sqlBuilder = sqlBuilder.
+			Where(keyset+" < ("+squirrel.Placeholders(len(keys))+")", keys...).
+			OrderBy(orderBy(sortColumns, "DESC")...)
 	case queryCursor.Direction == cursor.DirectionAfter:
-		sqlBuilder = sqlBuilder.Where("shift_id > ? ", queryCursor.Reference).OrderBy("shift_id ASC")
+		keys, err := shiftCursorKeys(queryCursor, sort)
+		if err != nil {
+			return "", nil, err
+		}
+
+		sqlBuilder = sqlBuilder.
+			Where(keyset+" > ("+squirrel.Placeholders(len(keys))+")", keys...).
+			OrderBy(orderBy(sortColumns, "ASC")...)
+	default:
+		return "", nil, errors.New("invalid queryCursor direction")
+	}
+
+	return sqlBuilder.ToSql()
+}
+
+// shiftFilterPredicates returns the predicates of the given filter over the
+// "Shift" s and "Facility" f tables.
+func shiftFilterPredicates(filter domain.ShiftFilter) squirrel.And {
+	// Shifts are stored in UTC, but dates and times of day are in the
+	// facility's time zone.
+	const (
+		localStart = "(s.start AT TIME ZONE 'UTC' AT TIME ZONE f.time_zone)"
+		// localMidnight is the start of the given date in the facility's
+		// time zone, in UTC.
+		localMidnight = "(?::timestamp AT TIME ZONE f.time_zone AT TIME ZONE 'UTC')"
+	)
+
+	predicates := squirrel.And{}
+
+	if !filter.Start.IsZero() && !filter.End.IsZero() {
+		// Dates are turned into half-open bounds in UTC rather than shifts
+		// into local dates, so the indexes on start can be used. As shifts end
+		// after they start, starting on the first date and ending before the
+		// day after the last one means both are within the dates.
+		dayAfterEnd := filter.End.AddDays(1).String()
+
+		predicates = append(predicates,
+			squirrel.Expr("s.start >= "+localMidnight, filter.Start.String()),
+			squirrel.Expr("s.start < "+localMidnight, dayAfterEnd),
+			squirrel.Expr("s.end < "+localMidnight, dayAfterEnd),
+		)
+	}
+
+	if len(filter.FacilityIDs) > 0 {
+		predicates = append(predicates, squirrel.Eq{"f.id": filter.FacilityIDs})
+	}
+
+	from, to := filter.StartTimeFrom, filter.StartTimeTo
+	switch {
+	case from != nil && to != nil && from.After(*to):
+		predicates = append(predicates, squirrel.Or{
+			squirrel.Expr(localStart+"::time >= ?::time", from.String()),
+			squirrel.Expr(localStart+"::time <= ?::time", to.String()),
+		})
+	default:
+		if from != nil {
+			predicates = append(predicates, squirrel.Expr(localStart+"::time >= ?::time", from.String()))
+		}
+		if to != nil {
+			predicates = append(predicates, squirrel.Expr(localStart+"::time <= ?::time", to.String()))
+		}
+	}
+
+	if filter.MinDuration > 0 {
+		predicates = append(predicates, squirrel.Expr("s.end - s.start >= MAKE_INTERVAL(secs => ?)", filter.MinDuration.Seconds()))
+	}
+
+	if filter.MaxDuration > 0 {
+		predicates = append(predicates, squirrel.Expr("s.end - s.start <= MAKE_INTERVAL(secs => ?)", filter.MaxDuration.Seconds()))
+	}
+
+	return predicates
+}
+
+// shiftSortColumns returns the columns of the available shifts query that
+// shifts are sorted by, in the same order as their cursor keys.
+func shiftSortColumns(sort domain.ShiftSort) ([]string, error) {
+	switch sort {
+	case domain.ShiftSortEnd:
+		return []string{"rounded_end", "shift_id"}, nil
+	case domain.ShiftSortFacility:
+		return []string{"facility_name", "rounded_start", "shift_id"}, nil
+	case domain.ShiftSortStart:
+		return []string{"rounded_start", "shift_id"}, nil
+	default:
+		return nil, fmt.Errorf("unknown shift sort %q", sort)
+	}
+}
+
+// shiftCursorKeys parses the keys of a cursor built by
+// domain.ShiftSort.CursorKeys into query arguments.
+func shiftCursorKeys(queryCursor *cursor.Cursor, sort domain.ShiftSort) ([]interface{}, error) {
+	var parsers []func(string) (interface{}, error)
+
+	switch sort {
+	case domain.ShiftSortEnd, domain.ShiftSortStart:
+		parsers = append(parsers, parseCursorTime, parseCursorID)
+	case domain.ShiftSortFacility:
+		parsers = append(parsers, parseCursorString, parseCursorTime, parseCursorID)
 	default:
-		return nil, errors.New("invalid queryCursor direction")
+		return nil, fmt.Errorf("unknown shift sort %q", sort)
+	}
+
+	if len(queryCursor.Keys) != len(parsers) {
+		return nil, fmt.Errorf("%w: expected %d keys for %q sort, got %d", domain.ErrInvalidCursor, len(parsers), sort, len(queryCursor.Keys))
+	}
+
+	keys := make([]interface{}, len(parsers))
+	for i, parse := range parsers {
+		key, err := parse(queryCursor.Keys[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidCursor, err)
+		}
+
+		keys[i] = key
+	}
+
+	return keys, nil
+}
+
+func parseCursorString(key string) (interface{}, error) {
+	return key, nil
+}
+
+func parseCursorTime(key string) (interface{}, error) {
+	t, err := time.Parse(time.RFC3339Nano, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.UTC(), nil
+}
+
+func parseCursorID(key string) (interface{}, error) {
+	return strconv.ParseInt(key, 10, 64)
+}
+
+func orderBy(columns []string, direction string) []string {
+	orderBy := make([]string, len(columns))
+	for i, c := range columns {
+		orderBy[i] = c + " " + direction
+	}
+
+	return orderBy
+}
+
+// ClaimShift assigns the shift to the worker as long as the worker is still
+// eligible for it. The eligibility check and the assignment happen in a single
+// statement, so concurrent claims for the same shift have exactly one winner.
+// Claims from the same worker are serialized so two overlapping shifts can't
+// be claimed at once.
+func (d *Database) ClaimShift(ctx context.Context, workerID int64, shiftID int64) (*domain.Shift, error) {
+	eligibleShift := d.eligibleShifts(workerID).
+		Columns("s.id").
+		Where("s.id = ?", shiftID).
+		PlaceholderFormat(squirrel.Question)
+
+	updateBuilder := d.sq.Update("\"Shift\" s")
+
+	for _, cte := range d.eligibilityCTEs(workerID) {
+		updateBuilder = updateBuilder.PrefixExpr(cte)
+	}
+
+	sql, args, err := updateBuilder.
+		Set("worker_id", workerID).
+		From("\"Facility\" f").
+		Where("f.id = s.facility_id").
+		// Checked against the target row itself so a concurrent claim that
+		// committed first makes this one update nothing.
+		Where("s.worker_id IS NULL").
+		Where(squirrel.Expr("s.id IN (?)", eligibleShift)).
+		Suffix("RETURNING f.id, f.name, f.time_zone, s.id, s.start, s.end").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var shift *domain.Shift
+
+	err = pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1, $2)", workerLockNamespace, workerID); err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		shifts, err := deserializeWorkerAvailableShifts(rows)
+		if err != nil {
+			return err
+		}
+
+		if len(shifts) == 0 {
+			return d.claimShiftError(ctx, tx, shiftID)
+		}
+
+		shift = &shifts[0]
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+// GetShiftEligibility evaluates every eligibility rule for the worker and the
+// shift individually.
+func (d *Database) GetShiftEligibility(ctx context.Context, workerID int64, shiftID int64) (*domain.Eligibility, error) {
+	rules := d.eligibilityRules()
+
+	// The index only tells whether the documents are held, so the missing
+	// ones are worked out from the requirements, but only when the index says
+	// they are not all held, so the explanation never contradicts the verdict.
+	sqlBuilder := d.shiftsForWorker(workerID).
+		Columns("s.id", "w.id").
+		Column("CASE WHEN " + documentsHeld + " THEN '{}'::integer[] ELSE ARRAY(SELECT UNNEST(fd.required_documents) EXCEPT " + validDocuments("s.start") + " ORDER BY 1) END").
+		LeftJoin("facility_documents fd ON fd.facility_id = f.id AND fd.profession = s.profession")
+
+	for _, rule := range rules {
+		sqlBuilder = sqlBuilder.Column(squirrel.Alias(squirrel.Expr("COALESCE(?, FALSE)", rule.predicate), string(rule.rule)))
+	}
+
+	for _, cte := range d.eligibilityCTEs(workerID) {
+		sqlBuilder = sqlBuilder.PrefixExpr(cte)
+	}
+
+	sqlBuilder = sqlBuilder.
+		PrefixExpr(d.facilityDocuments().Prefix(", facility_documents AS (").Suffix(")")).
+		PrefixExpr(d.workerDocuments(workerID).Prefix(", worker_documents AS (").Suffix(")"))
+
+	sql, args, err := sqlBuilder.
+		Where("s.id = ?", shiftID).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	eligibility := &domain.Eligibility{
+		Checks: make([]domain.EligibilityCheck, len(rules)),
+	}
+
+	dest := []interface{}{
+		&eligibility.ShiftID,
+		&eligibility.WorkerID,
+		&eligibility.MissingDocumentIDs,
+	}
+
+	for i, rule := range rules {
+		eligibility.Checks[i].Rule = rule.rule
+		dest = append(dest, &eligibility.Checks[i].Passed)
+	}
+
+	if err := d.Pool.QueryRow(ctx, sql, args...).Scan(dest...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, d.shiftEligibilityError(ctx, workerID)
+		}
+
+		return nil, err
+	}
+
+	return eligibility, nil
+}
+
+// shiftEligibilityError tells whether it was the worker or the shift that
+// could not be found.
+func (d *Database) shiftEligibilityError(ctx context.Context, workerID int64) error {
+	if err := d.checkWorkerExists(ctx, d.Pool, workerID); err != nil {
+		return err
+	}
+
+	return domain.ErrShiftNotFound
+}
+
+// checkWorkerExists returns domain.ErrWorkerNotFound when the worker does not
+// exist.
+func (d *Database) checkWorkerExists(ctx context.Context, q rowQuerier, workerID int64) error {
+	sql, args, err := d.sq.
+		Select("1").
+		From("\"Worker\"").
+		Where("id = ?", workerID).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var found int
+	if err := q.QueryRow(ctx, sql, args...).Scan(&found); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrWorkerNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// CancelShift releases a shift claimed by the worker as long as it starts after
+// the given time, recording the cancellation.
+func (d *Database) CancelShift(ctx context.Context, workerID int64, shiftID int64, startsAfter time.Time) (*domain.Shift, error) {
+	var shift *domain.Shift
+
+	err := pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		sql, args, err := d.sq.
+			Update("\"Shift\" s").
+			Set("worker_id", nil).
+			From("\"Facility\" f").
+			Where("f.id = s.facility_id").
+			Where("s.id = ?", shiftID).
+			Where("s.worker_id = ?", workerID).
+			Where("s.start > ?", startsAfter.UTC()).
+			Suffix("RETURNING f.id, f.name, f.time_zone, s.id, s.start, s.end").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		shifts, err := deserializeWorkerAvailableShifts(rows)
+		if err != nil {
+			return err
+		}
+
+		if len(shifts) == 0 {
+			return d.cancelShiftError(ctx, tx, workerID, shiftID, startsAfter)
+		}
+
+		if err := d.recordShiftCancellation(ctx, tx, shiftID, workerID, cancelledByWorker); err != nil {
+			return err
+		}
+
+		shift = &shifts[0]
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	sql, args, err := sqlBuilder.ToSql()
+	return shift, nil
+}
+
+// GetWorker returns the worker, or domain.ErrWorkerNotFound when it does not
+// exist.
+func (d *Database) GetWorker(ctx context.Context, workerID int64) (*domain.Worker, error) {
+	sql, args, err := d.workers().
+		Where("w.id = ?", workerID).
+		ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -92,14 +469,377 @@ func (d *Database) GetAvailableShifts(ctx context.Context, queryCursor *cursor.C
 		return nil, err
 	}
 
+	workers, err := deserializeWorkers(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(workers) == 0 {
+		return nil, domain.ErrWorkerNotFound
+	}
+
+	return &workers[0], nil
+}
+
+// UpdateWorker changes the worker's profile. Deactivating a worker releases
+// the shifts they claimed starting after releaseAfter, recording each release
+// as a cancellation by the platform. Updates are serialized with the worker's
+// claims, so no shift can be claimed by a worker being deactivated.
+func (d *Database) UpdateWorker(ctx context.Context, workerID int64, update domain.WorkerUpdate, releaseAfter time.Time) (*domain.WorkerChange, error) {
+	updateBuilder := d.sq.
+		Update("\"Worker\" w").
+		Where("w.id = ?", workerID).
+		Suffix("RETURNING " + strings.Join(workerColumns, ", "))
+
+	if update.IsActive != nil {
+		updateBuilder = updateBuilder.Set("is_active", *update.IsActive)
+	}
+
+	if update.Profession != nil {
+		updateBuilder = updateBuilder.Set("profession", string(*update.Profession))
+	}
+
+	sql, args, err := updateBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var change *domain.WorkerChange
+
+	err = pgx.BeginFunc(ctx, d.Pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1, $2)", workerLockNamespace, workerID); err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		workers, err := deserializeWorkers(rows)
+		if err != nil {
+			return err
+		}
+
+		if len(workers) == 0 {
+			return domain.ErrWorkerNotFound
+		}
+
+		change = &domain.WorkerChange{
+			ReleasedShifts: []domain.Shift{},
+			Worker:         workers[0],
+		}
+
+		if workers[0].IsActive {
+			return nil
+		}
+
+		change.ReleasedShifts, err = d.releaseWorkerShifts(ctx, tx, workerID, releaseAfter)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// releaseWorkerShifts releases the shifts claimed by the worker starting after
+// the given time, recording each release as a cancellation by the platform.
+func (d *Database) releaseWorkerShifts(ctx context.Context, tx pgx.Tx, workerID int64, startsAfter time.Time) ([]domain.Shift, error) {
+	sql, args, err := d.sq.
+		Update("\"Shift\" s").
+		Set("worker_id", nil).
+		From("\"Facility\" f").
+		Where("f.id = s.facility_id").
+		Where("s.worker_id = ?", workerID).
+		Where("s.is_deleted = FALSE").
+		Where("s.start > ?", startsAfter.UTC()).
+		Suffix("RETURNING f.id, f.name, f.time_zone, s.id, s.start, s.end").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
 	shifts, err := deserializeWorkerAvailableShifts(rows)
 	if err != nil {
 		return nil, err
 	}
 
+	sort.Slice(shifts, func(i, j int) bool {
+		if shifts[i].Start.Equal(shifts[j].Start) {
+			return shifts[i].ID < shifts[j].ID
+		}
+
+		return shifts[i].Start.Before(shifts[j].Start)
+	})
+
+	for _, shift := range shifts {
+		if err := d.recordShiftCancellation(ctx, tx, shift.ID, workerID, cancelledByPlatform); err != nil {
+			return nil, err
+		}
+	}
+
 	return shifts, nil
 }
 
+// Who cancelled a claimed shift, either the worker who claimed it, the
+// shift's facility or the platform itself, as when deactivating the worker.
+const (
+	cancelledByFacility = "FACILITY"
+	cancelledByPlatform = "PLATFORM"
+	cancelledByWorker   = "WORKER"
+)
+
+// recordShiftCancellation records that the shift claimed by the worker was
+// cancelled, and by whom.
+func (d *Database) recordShiftCancellation(ctx context.Context, tx pgx.Tx, shiftID int64, workerID int64, cancelledBy string) error {
+	sql, args, err := d.sq.
+		Insert("\"ShiftCancellation\"").
+		Columns("shift_id", "worker_id", "cancelled_by").
+		Values(shiftID, workerID, cancelledBy).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+
+	return err
+}
+
+// claimShiftError tells why a shift could not be claimed.
+func (d *Database) claimShiftError(ctx context.Context, q rowQuerier, shiftID int64) error {
+	status, err := d.getShiftStatus(ctx, q, shiftID)
+	if err != nil {
+		return err
+	}
+
+	if status.claimedBy != nil {
+		return domain.ErrShiftAlreadyClaimed
+	}
+
+	return domain.ErrWorkerNotEligible
+}
+
+// cancelShiftError tells why a shift could not be cancelled.
+func (d *Database) cancelShiftError(ctx context.Context, q rowQuerier, workerID int64, shiftID int64, startsAfter time.Time) error {
+	status, err := d.getShiftStatus(ctx, q, shiftID)
+	if err != nil {
+		return err
+	}
+
+	if status.claimedBy == nil || *status.claimedBy != workerID {
+		return domain.ErrShiftNotClaimedByWorker
+	}
+
+	if !status.start.After(startsAfter.UTC()) {
+		return domain.ErrCancellationCutoff
+	}
+
+	return errors.New("shift could not be cancelled")
+}
+
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+type shiftStatus struct {
+	claimedBy *int64
+	start     time.Time
+}
+
+// getShiftStatus returns what is needed to tell why a shift could not be
+// changed, or domain.ErrShiftNotFound when it does not exist or was deleted.
+func (d *Database) getShiftStatus(ctx context.Context, q rowQuerier, shiftID int64) (*shiftStatus, error) {
+	sql, args, err := d.sq.
+		Select("worker_id", "start").
+		From("\"Shift\"").
+		Where("id = ?", shiftID).
+		Where("is_deleted = FALSE").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &shiftStatus{}
+
+	if err := q.QueryRow(ctx, sql, args...).Scan(&status.claimedBy, &status.start); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrShiftNotFound
+		}
+
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// eligibleShifts selects from the shifts the given worker is eligible for,
+// aliased as s, along with their facility (f) and the worker (w). It relies on
+// the CTEs built by eligibilityCTEs.
+func (d *Database) eligibleShifts(workerID int64) squirrel.SelectBuilder {
+	builder := d.shiftsForWorker(workerID)
+
+	for _, rule := range d.eligibilityRules() {
+		builder = builder.Where(rule.predicate)
+	}
+
+	return builder
+}
+
+// shiftsForWorker selects from every shift, aliased as s, joined with its
+// facility (f) and the given worker (w), so eligibility rules can be evaluated
+// over them.
+func (d *Database) shiftsForWorker(workerID int64) squirrel.SelectBuilder {
+	return d.sq.
+		Select().
+		From("\"Shift\" s").
+		InnerJoin("\"Facility\" f ON f.id = s.facility_id").
+		InnerJoin("\"Worker\" w ON w.id = ?", workerID)
+}
+
+// documentsHeld tells whether the worker w holds every document the facility
+// f requires for the worker's profession, still valid when the shift s starts.
+// It is looked up in WorkerFacilityEligibility, which triggers keep up to date
+// as documents, requirements and workers change, rather than aggregating the
+// requirements and documents for every shift.
+const documentsHeld = "EXISTS (SELECT 1 FROM \"WorkerFacilityEligibility\" wfe WHERE wfe.worker_id = w.id AND wfe.facility_id = f.id AND (wfe.eligible_until IS NULL OR wfe.eligible_until > s.start))"
+
+// validDocuments selects the IDs of the documents in worker_documents, as
+// selected by workerDocuments, that are still valid at the given SQL
+// expression.
+func validDocuments(at string) string {
+	return "SELECT wd.document_id FROM worker_documents wd WHERE wd.expires_at IS NULL OR wd.expires_at > " + at
+}
+
+// eligibilityRule is a condition a worker must meet to be eligible for a
+// shift, expressed over the aliases set by shiftsForWorker and
+// workersForShift.
+type eligibilityRule struct {
+	predicate squirrel.Sqlizer
+	rule      domain.EligibilityRule
+}
+
+// eligibilityRules returns every rule a worker must meet to be eligible for a
+// shift. They are the single source of truth for both filtering eligible shifts
+// and explaining why a worker is not eligible for one.
+func (d *Database) eligibilityRules() []eligibilityRule {
+	minRestPeriod := d.minRestPeriod.Seconds()
+
+	return []eligibilityRule{
+		{
+			predicate: squirrel.Expr(activeFacility),
+			rule:      domain.EligibilityRuleFacilityActive,
+		},
+		{
+			predicate: squirrel.Expr("s.is_deleted = FALSE"),
+			rule:      domain.EligibilityRuleShiftActive,
+		},
+		{
+			predicate: squirrel.Expr("s.worker_id IS NULL"),
+			rule:      domain.EligibilityRuleShiftUnclaimed,
+		},
+		{
+			predicate: squirrel.Expr("w.is_active = TRUE"),
+			rule:      domain.EligibilityRuleWorkerActive,
+		},
+		{
+			predicate: squirrel.Expr("w.profession = s.profession"),
+			rule:      domain.EligibilityRuleProfessionMatch,
+		},
+		{
+			predicate: squirrel.Expr(documentsHeld),
+			rule:      domain.EligibilityRuleDocumentsHeld,
+		},
+		{
+			predicate: squirrel.Expr(
+				"NOT EXISTS (SELECT 1 FROM worker_shifts ws WHERE ws.worker_id = w.id AND ws.id <> s.id AND ws.start < s.end + MAKE_INTERVAL(secs => ?) AND s.start < ws.end + MAKE_INTERVAL(secs => ?))",
+				minRestPeriod,
+				minRestPeriod,
+			),
+			rule: domain.EligibilityRuleNoOverlap,
+		},
+	}
+}
+
+// eligibilityCTEs returns the CTEs eligibleShifts relies on, to be prefixed in
+// order. Callers adding their own CTEs must start them with a comma.
+func (d *Database) eligibilityCTEs(workerID int64) []squirrel.Sqlizer {
+	return []squirrel.Sqlizer{
+		// Materialized so the worker's shifts are read once rather than for
+		// every candidate shift.
+		d.workerShifts(workerID).
+			Prefix("WITH worker_shifts AS MATERIALIZED (").Suffix(")"),
+	}
+}
+
+// facilityDocuments selects the documents required by each facility, for each
+// profession. Requirements without a profession apply to every profession.
+// Inactive documents are retired, so they are not required anymore.
+func (d *Database) facilityDocuments() squirrel.SelectBuilder {
+	return d.sq.
+		Select(
+			"fr.facility_id",
+			"p.profession",
+			"ARRAY_AGG(DISTINCT fr.document_id) AS required_documents",
+		).
+		From("\"FacilityRequirement\" fr").
+		InnerJoin("\"Document\" doc ON doc.id = fr.document_id").
+		CrossJoin("UNNEST(ENUM_RANGE(NULL::\"Profession\")) AS p(profession)").
+		Where("doc.is_active = TRUE").
+		Where("(fr.profession IS NULL OR fr.profession = p.profession)").
+		GroupBy("fr.facility_id", "p.profession")
+}
+
+// workerDocuments selects the verified documents held by the worker.
+func (d *Database) workerDocuments(workerID int64) squirrel.SelectBuilder {
+	return d.verifiedDocuments().
+		Where("dw.worker_id = ?", workerID)
+}
+
+// verifiedDocuments selects the verified documents held by workers, along with
+// when they expire, if ever. Inactive documents are retired, so they don't
+// count.
+func (d *Database) verifiedDocuments() squirrel.SelectBuilder {
+	return d.sq.
+		Select(
+			"dw.worker_id",
+			"dw.document_id",
+			"dw.expires_at",
+		).
+		From("\"DocumentWorker\" dw").
+		InnerJoin("\"Document\" doc ON doc.id = dw.document_id").
+		Where("dw.status = 'VERIFIED'").
+		Where("doc.is_active = TRUE")
+}
+
+// workerShifts selects the shifts claimed by the worker.
+func (d *Database) workerShifts(workerID int64) squirrel.SelectBuilder {
+	return d.claimedShifts().
+		Where("worker_id = ?", workerID)
+}
+
+// claimedShifts selects the shifts claimed by workers.
+func (d *Database) claimedShifts() squirrel.SelectBuilder {
+	return d.sq.
+		Select(
+			"worker_id",
+			"id",
+			"start",
+			"\"end\"",
+		).
+		From("\"Shift\"").
+		Where("worker_id IS NOT NULL").
+		Where("is_deleted = FALSE")
+}
+
 func deserializeWorkerAvailableShifts(rows pgx.Rows) (domain.Shifts, error) {
 	defer rows.Close()
 
@@ -107,9 +847,12 @@ func deserializeWorkerAvailableShifts(rows pgx.Rows) (domain.Shifts, error) {
 	for rows.Next() {
 		s := domain.Shift{}
 
+		var timeZone string
+
 		if err := rows.Scan(
 			&s.Facility.ID,
 			&s.Facility.Name,
+			&timeZone,
 			&s.ID,
 			&s.Start,
 			&s.End,
@@ -121,8 +864,40 @@ func deserializeWorkerAvailableShifts(rows pgx.Rows) (domain.Shifts, error) {
 			return nil, err
 		}
 
+		loc, err := loadLocation(timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("facility %d: %w", s.Facility.ID, err)
+		}
+
+		s.Facility.TimeZone = loc
+		s.Start = s.Start.In(loc)
+		s.End = s.End.In(loc)
+
 		shifts = append(shifts, s)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
 	return shifts, nil
 }
+
+// locations caches the time zones of facilities by name, as loading them
+// reads the time zone database.
+var locations sync.Map
+
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	locations.Store(name, loc)
+
+	return loc, nil
+}
@@ -2,13 +2,21 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httputil"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rodrigosdo/facilities-api/internal/cursor"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
 	"github.com/rodrigosdo/facilities-api/internal/postgres"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/document"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/shift"
 	"github.com/rodrigosdo/facilities-api/internal/usecase/worker"
 
 	"cloud.google.com/go/civil"
@@ -79,32 +87,1282 @@ func (h HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func GetAvailableShiftsFromWorker(uc worker.AvailableShifts) HandlerFunc {
+func GetAvailableShiftsFromWorker(uc worker.AvailableShifts, codec *cursor.Codec) HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) *Error {
-		queryCursor, err := cursor.Parse(r.URL.Query().Get("cursor"))
+		start, err := parseDate(r.URL.Query().Get("start"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "start date query param is invalid",
+			}
+		}
+
+		end, err := parseDate(r.URL.Query().Get("end"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "end date query param is invalid",
+			}
+		}
+
+		limit, err := parseLimit(r.URL.Query().Get("limit"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "limit query param is invalid",
+			}
+		}
+
+		groupBy, err := parseGroupBy(r.URL.Query().Get("group_by"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "group_by query param is invalid",
+			}
+		}
+
+		shiftSort, err := parseShiftSort(r.URL.Query().Get("sort"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "sort query param is invalid",
+			}
+		}
+
+		facilityIDs, err := parseFacilityIDs(r.URL.Query()["facility_id"])
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility_id query param is invalid",
+			}
+		}
+
+		startTimeFrom, err := parseTimeOfDay(r.URL.Query().Get("start_time_from"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "start_time_from query param is invalid",
+			}
+		}
+
+		startTimeTo, err := parseTimeOfDay(r.URL.Query().Get("start_time_to"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "start_time_to query param is invalid",
+			}
+		}
+
+		minDuration, err := parseDuration(r.URL.Query().Get("min_duration"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "min_duration query param is invalid",
+			}
+		}
+
+		maxDuration, err := parseDuration(r.URL.Query().Get("max_duration"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "max_duration query param is invalid",
+			}
+		}
+
+		workerID, err := parseWorkerID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "worker id is invalid",
+			}
+		}
+
+		req := worker.GetAvailableShiftsRequest{
+			End:           *end,
+			FacilityIDs:   facilityIDs,
+			GroupBy:       groupBy,
+			Limit:         limit,
+			MaxDuration:   maxDuration,
+			MinDuration:   minDuration,
+			Sort:          shiftSort,
+			Start:         *start,
+			StartTimeFrom: startTimeFrom,
+			StartTimeTo:   startTimeTo,
+			WorkerID:      workerID,
+		}
+
+		cursorScope := availableShiftsCursorScope(req)
+
+		req.Cursor, err = codec.Decode(r.URL.Query().Get("cursor"), cursorScope)
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: fmt.Sprintf("cursor query param is invalid: %s", err),
+			}
+		}
+
+		availableShifts, err := uc.GetAvailableShifts(r.Context(), req)
+		if err != nil {
+			return newUseCaseError(err, "failed to get available shifts")
+		}
+
+		nextCursor, err := encodeCursor(codec, availableShifts.NextCursor, cursorScope)
+		if err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode next cursor",
+			}
+		}
+
+		prevCursor, err := encodeCursor(codec, availableShifts.PrevCursor, cursorScope)
+		if err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode previous cursor",
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		var resp interface{}
+
+		if groupBy == worker.GroupByDate {
+			dateResp := GetAvailableShiftsByDateFromUserReponse{
+				NextCursor: nextCursor,
+				PrevCursor: prevCursor,
+			}
+
+			for _, d := range availableShifts.Dates {
+				dateResp.Dates = append(dateResp.Dates, AvailableShiftsOfDate{
+					Date:    d.Date,
+					Partial: d.Partial,
+					Shifts:  newAvailableShifts(d.Shifts),
+				})
+			}
+
+			resp = dateResp
+		} else {
+			resp = GetAvailableShiftsFromUserReponse{
+				NextCursor: nextCursor,
+				PrevCursor: prevCursor,
+				Shifts:     newAvailableShifts(availableShifts.Shifts),
+			}
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func ClaimShiftFromWorker(uc worker.ClaimShift) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		workerID, err := parseWorkerID(params.ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "worker id is invalid",
+			}
+		}
+
+		shiftID, err := parseShiftID(params.ByName("shift_id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "shift id is invalid",
+			}
+		}
+
+		claimedShift, err := uc.ClaimShift(r.Context(), worker.ClaimShiftRequest{
+			ShiftID:  shiftID,
+			WorkerID: workerID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to claim shift")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := ClaimShiftFromWorkerResponse{
+			Shift: newAvailableShift(claimedShift.Shift),
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func CancelShiftFromWorker(uc worker.CancelShift) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		workerID, err := parseWorkerID(params.ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "worker id is invalid",
+			}
+		}
+
+		shiftID, err := parseShiftID(params.ByName("shift_id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "shift id is invalid",
+			}
+		}
+
+		cancelledShift, err := uc.CancelShift(r.Context(), worker.CancelShiftRequest{
+			ShiftID:  shiftID,
+			WorkerID: workerID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to cancel shift")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := CancelShiftFromWorkerResponse{
+			Shift: newAvailableShift(cancelledShift.Shift),
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func GetShiftEligibilityFromWorker(uc worker.ShiftEligibility) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		workerID, err := parseWorkerID(params.ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "worker id is invalid",
+			}
+		}
+
+		shiftID, err := parseShiftID(params.ByName("shift_id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "shift id is invalid",
+			}
+		}
+
+		shiftEligibility, err := uc.GetShiftEligibility(r.Context(), worker.GetShiftEligibilityRequest{
+			ShiftID:  shiftID,
+			WorkerID: workerID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to get shift eligibility")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		eligibility := shiftEligibility.Eligibility
+
+		resp := GetShiftEligibilityFromWorkerResponse{
+			Eligibility: ShiftEligibility{
+				Eligible:           eligibility.Eligible(),
+				MissingDocumentIDs: eligibility.MissingDocumentIDs,
+				ShiftID:            eligibility.ShiftID,
+				WorkerID:           eligibility.WorkerID,
+			},
+		}
+
+		for _, c := range eligibility.Checks {
+			resp.Eligibility.Checks = append(resp.Eligibility.Checks, EligibilityCheck{
+				Passed: c.Passed,
+				Rule:   string(c.Rule),
+			})
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func GetWorkerDocuments(uc document.WorkerDocuments) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		workerID, err := parseWorkerID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "worker id is invalid",
+			}
+		}
+
+		workerDocuments, err := uc.GetWorkerDocuments(r.Context(), document.GetWorkerDocumentsRequest{
+			WorkerID: workerID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to get worker documents")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := GetWorkerDocumentsResponse{
+			WorkerDocuments: []WorkerDocument{},
+		}
+
+		for _, wd := range workerDocuments.WorkerDocuments {
+			resp.WorkerDocuments = append(resp.WorkerDocuments, newWorkerDocument(wd))
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func AddWorkerDocument(uc document.AddWorkerDocument) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		workerID, err := parseWorkerID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "worker id is invalid",
+			}
+		}
+
+		var body AddWorkerDocumentRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "request body is invalid",
+			}
+		}
+
+		if body.DocumentID <= 0 {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   errors.New("document_id is required"),
+				Message: "document_id is invalid",
+			}
+		}
+
+		addedDocument, err := uc.AddWorkerDocument(r.Context(), document.AddWorkerDocumentRequest{
+			DocumentID: body.DocumentID,
+			ExpiresAt:  body.ExpiresAt,
+			WorkerID:   workerID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to add worker document")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		resp := AddWorkerDocumentResponse{
+			NewlyEligibleFacilities: []Facility{},
+			WorkerDocument:          newWorkerDocument(addedDocument.WorkerDocument),
+		}
+
+		for _, f := range addedDocument.NewlyEligibleFacilities {
+			resp.NewlyEligibleFacilities = append(resp.NewlyEligibleFacilities, newFacility(f))
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func RemoveWorkerDocument(uc document.RemoveWorkerDocument) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		workerID, err := parseWorkerID(params.ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "worker id is invalid",
+			}
+		}
+
+		documentID, err := parseDocumentID(params.ByName("document_id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "document id is invalid",
+			}
+		}
+
+		err = uc.RemoveWorkerDocument(r.Context(), document.RemoveWorkerDocumentRequest{
+			DocumentID: documentID,
+			WorkerID:   workerID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to remove worker document")
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+
+		return nil
+	}
+}
+
+func GetFacilityRequirements(uc facility.Requirements) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		facilityID, err := parseFacilityID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		requirements, err := uc.GetRequirements(r.Context(), facility.GetRequirementsRequest{
+			FacilityID: facilityID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to get facility requirements")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := GetFacilityRequirementsResponse{
+			Requirements: []FacilityRequirement{},
+		}
+
+		for _, fr := range requirements.Requirements {
+			resp.Requirements = append(resp.Requirements, newFacilityRequirement(fr))
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func AddFacilityRequirement(uc facility.AddRequirement) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		facilityID, err := parseFacilityID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		dryRun, err := parseBoolQuery(r, "dry_run")
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "dry_run query param is invalid",
+			}
+		}
+
+		body, decodeErr := decodeFacilityRequirementRequest(r)
+		if decodeErr != nil {
+			return decodeErr
+		}
+
+		added, err := uc.AddRequirement(r.Context(), facility.AddRequirementRequest{
+			DocumentID: body.DocumentID,
+			DryRun:     dryRun,
+			FacilityID: facilityID,
+			Profession: body.Profession,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to add facility requirement")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if !dryRun {
+			w.WriteHeader(http.StatusCreated)
+		}
+
+		if err := json.NewEncoder(w).Encode(newFacilityRequirementChangeResponse(added.Change, dryRun)); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func UpdateFacilityRequirement(uc facility.UpdateRequirement) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		facilityID, err := parseFacilityID(params.ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		requirementID, err := parseRequirementID(params.ByName("requirement_id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "requirement id is invalid",
+			}
+		}
+
+		dryRun, err := parseBoolQuery(r, "dry_run")
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "dry_run query param is invalid",
+			}
+		}
+
+		body, decodeErr := decodeFacilityRequirementRequest(r)
+		if decodeErr != nil {
+			return decodeErr
+		}
+
+		updated, err := uc.UpdateRequirement(r.Context(), facility.UpdateRequirementRequest{
+			DocumentID:    body.DocumentID,
+			DryRun:        dryRun,
+			FacilityID:    facilityID,
+			Profession:    body.Profession,
+			RequirementID: requirementID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to update facility requirement")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(newFacilityRequirementChangeResponse(updated.Change, dryRun)); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func RemoveFacilityRequirement(uc facility.RemoveRequirement) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		facilityID, err := parseFacilityID(params.ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		requirementID, err := parseRequirementID(params.ByName("requirement_id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "requirement id is invalid",
+			}
+		}
+
+		dryRun, err := parseBoolQuery(r, "dry_run")
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "dry_run query param is invalid",
+			}
+		}
+
+		removed, err := uc.RemoveRequirement(r.Context(), facility.RemoveRequirementRequest{
+			DryRun:        dryRun,
+			FacilityID:    facilityID,
+			RequirementID: requirementID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to remove facility requirement")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(newFacilityRequirementChangeResponse(removed.Change, dryRun)); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func CreateFacilityShift(uc facility.CreateShift) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		facilityID, err := parseFacilityID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		var body CreateFacilityShiftRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "request body is invalid",
+			}
+		}
+
+		created, err := uc.CreateShift(r.Context(), facility.CreateShiftRequest{
+			End:        body.End,
+			FacilityID: facilityID,
+			Profession: body.Profession,
+			Start:      body.Start,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to create shift")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		resp := FacilityShiftResponse{
+			Shift: newFacilityShift(created.Shift),
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func UpdateFacilityShift(uc facility.UpdateShift) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		facilityID, err := parseFacilityID(params.ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		shiftID, err := parseShiftID(params.ByName("shift_id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "shift id is invalid",
+			}
+		}
+
+		unassign, err := parseBoolQuery(r, "unassign")
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "unassign query param is invalid",
+			}
+		}
+
+		var body UpdateFacilityShiftRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "request body is invalid",
+			}
+		}
+
+		updated, err := uc.UpdateShift(r.Context(), facility.UpdateShiftRequest{
+			FacilityID: facilityID,
+			ShiftID:    shiftID,
+			Unassign:   unassign,
+			Update: domain.ShiftUpdate{
+				End:        body.End,
+				Profession: body.Profession,
+				Start:      body.Start,
+			},
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to update shift")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := FacilityShiftResponse{
+			Shift: newFacilityShift(updated.Shift),
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func DeleteFacilityShift(uc facility.DeleteShift) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		facilityID, err := parseFacilityID(params.ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		shiftID, err := parseShiftID(params.ByName("shift_id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "shift id is invalid",
+			}
+		}
+
+		unassign, err := parseBoolQuery(r, "unassign")
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "unassign query param is invalid",
+			}
+		}
+
+		deleted, err := uc.DeleteShift(r.Context(), facility.DeleteShiftRequest{
+			FacilityID: facilityID,
+			ShiftID:    shiftID,
+			Unassign:   unassign,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to delete shift")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := FacilityShiftResponse{
+			Shift: newFacilityShift(deleted.Shift),
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func GetShiftTemplates(uc facility.ShiftTemplates) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		facilityID, err := parseFacilityID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		templates, err := uc.GetShiftTemplates(r.Context(), facility.GetShiftTemplatesRequest{
+			FacilityID: facilityID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to get shift templates")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := GetShiftTemplatesResponse{
+			Templates: []ShiftTemplate{},
+		}
+
+		for _, st := range templates.Templates {
+			resp.Templates = append(resp.Templates, newShiftTemplate(st))
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func CreateShiftTemplate(uc facility.CreateShiftTemplate) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		facilityID, err := parseFacilityID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		var body CreateShiftTemplateRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "request body is invalid",
+			}
+		}
+
+		daysOfWeek, err := parseDaysOfWeek(body.DaysOfWeek)
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "days_of_week is invalid",
+			}
+		}
+
+		startTime, err := parseTimeOfDay(body.StartTime)
+		if err == nil && startTime == nil {
+			err = errors.New("start_time is required")
+		}
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "start_time is invalid",
+			}
+		}
+
+		duration, err := parseDuration(body.Duration)
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "duration is invalid",
+			}
+		}
+
+		created, err := uc.CreateShiftTemplate(r.Context(), facility.CreateShiftTemplateRequest{
+			Template: domain.ShiftTemplate{
+				Count:      body.Count,
+				DaysOfWeek: daysOfWeek,
+				Duration:   duration,
+				FacilityID: facilityID,
+				Headcount:  body.Headcount,
+				Profession: body.Profession,
+				StartTime:  *startTime,
+				StartsOn:   body.StartsOn,
+			},
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to create shift template")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		resp := ShiftTemplateResponse{
+			Template: newShiftTemplate(created.Template),
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func MaterializeShiftTemplate(uc facility.MaterializeShiftTemplate) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		facilityID, err := parseFacilityID(params.ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		templateID, err := parseTemplateID(params.ByName("template_id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "template id is invalid",
+			}
+		}
+
+		var body MaterializeShiftTemplateRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "request body is invalid",
+			}
+		}
+
+		materialized, err := uc.MaterializeShiftTemplate(r.Context(), facility.MaterializeShiftTemplateRequest{
+			End:        body.End,
+			FacilityID: facilityID,
+			Start:      body.Start,
+			TemplateID: templateID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to materialize shift template")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := MaterializeShiftTemplateResponse{
+			Existing: materialized.Materialization.Existing,
+			Shifts:   []FacilityShift{},
+		}
+
+		for _, fs := range materialized.Materialization.Created {
+			resp.Shifts = append(resp.Shifts, newFacilityShift(fs))
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func GetFacilityStatusChanges(uc facility.StatusChanges) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		facilityID, err := parseFacilityID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		changes, err := uc.GetStatusChanges(r.Context(), facility.GetStatusChangesRequest{
+			FacilityID: facilityID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to get facility status changes")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := GetFacilityStatusChangesResponse{
+			Changes: []FacilityStatusChange{},
+		}
+
+		for _, c := range changes.Changes {
+			resp.Changes = append(resp.Changes, newFacilityStatusChange(c))
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+// ChangeFacilityStatus applies the given action to the facility. Suspensions
+// read until when from the request body.
+func ChangeFacilityStatus(uc facility.ChangeStatus, action domain.FacilityAction) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		facilityID, err := parseFacilityID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "facility id is invalid",
+			}
+		}
+
+		cancelClaims, err := parseBoolQuery(r, "cancel_claims")
+		if err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "cancel_claims query param is invalid",
+			}
+		}
+
+		var body SuspendFacilityRequest
+		if action == domain.FacilityActionSuspend {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return &Error{
+					Code:    http.StatusBadRequest,
+					Error:   err,
+					Message: "request body is invalid",
+				}
+			}
+		}
+
+		changed, err := uc.ChangeStatus(r.Context(), facility.ChangeStatusRequest{
+			Action:         action,
+			CancelClaims:   cancelClaims,
+			FacilityID:     facilityID,
+			SuspendedUntil: body.Until,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to change facility status")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		change := changed.Change
+
+		resp := FacilityLifecycleChangeResponse{
+			AffectedWorkers: []AffectedWorker{},
+			Change:          newFacilityStatusChange(change.Change),
+			Status: FacilityStatus{
+				IsActive:       change.Status.IsActive,
+				SuspendedUntil: change.Status.SuspendedUntil,
+			},
+		}
+
+		for _, aw := range change.AffectedWorkers {
+			resp.AffectedWorkers = append(resp.AffectedWorkers, AffectedWorker{
+				ShiftIDs: aw.ShiftIDs,
+				WorkerID: aw.WorkerID,
+			})
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func GetWorker(uc worker.GetWorker) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		workerID, err := parseWorkerID(params.ByName("id"))
 		if err != nil {
 			return &Error{
 				Code:    http.StatusBadRequest,
 				Error:   err,
-				Message: "cursor query param is invalid",
+				Message: "worker id is invalid",
 			}
 		}
 
-		start, err := parseDate(r.URL.Query().Get("start"))
+		found, err := uc.GetWorker(r.Context(), worker.GetWorkerRequest{
+			WorkerID: workerID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to get worker")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := WorkerResponse{
+			Worker: newWorker(found.Worker),
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func UpdateWorker(uc worker.UpdateWorker) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		params := httprouter.ParamsFromContext(r.Context())
+
+		workerID, err := parseWorkerID(params.ByName("id"))
 		if err != nil {
 			return &Error{
 				Code:    http.StatusBadRequest,
 				Error:   err,
-				Message: "start date query param is invalid",
+				Message: "worker id is invalid",
 			}
 		}
 
-		end, err := parseDate(r.URL.Query().Get("end"))
+		var body UpdateWorkerRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return &Error{
+				Code:    http.StatusBadRequest,
+				Error:   err,
+				Message: "request body is invalid",
+			}
+		}
+
+		updated, err := uc.UpdateWorker(r.Context(), worker.UpdateWorkerRequest{
+			Update: domain.WorkerUpdate{
+				IsActive:   body.IsActive,
+				Profession: body.Profession,
+			},
+			WorkerID: workerID,
+		})
+		if err != nil {
+			return newUseCaseError(err, "failed to update worker")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		resp := UpdateWorkerResponse{
+			ReleasedShifts: []AvailableShift{},
+			Worker:         newWorker(updated.Worker),
+		}
+
+		for _, s := range updated.ReleasedShifts {
+			resp.ReleasedShifts = append(resp.ReleasedShifts, newAvailableShift(s))
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode response",
+			}
+		}
+
+		return nil
+	}
+}
+
+func GetEligibleWorkersForShift(uc shift.EligibleWorkers, codec *cursor.Codec) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) *Error {
+		shiftID, err := parseShiftID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
 		if err != nil {
 			return &Error{
 				Code:    http.StatusBadRequest,
 				Error:   err,
-				Message: "end date query param is invalid",
+				Message: "shift id is invalid",
 			}
 		}
 
@@ -117,46 +1375,55 @@ func GetAvailableShiftsFromWorker(uc worker.AvailableShifts) HandlerFunc {
 			}
 		}
 
-		workerID, err := parseWorkerID(httprouter.ParamsFromContext(r.Context()).ByName("id"))
+		req := shift.GetEligibleWorkersRequest{
+			Limit:   limit,
+			ShiftID: shiftID,
+		}
+
+		cursorScope := fmt.Sprintf("eligible_workers:%d", shiftID)
+
+		req.Cursor, err = codec.Decode(r.URL.Query().Get("cursor"), cursorScope)
 		if err != nil {
 			return &Error{
 				Code:    http.StatusBadRequest,
 				Error:   err,
-				Message: "worker id is invalid",
+				Message: fmt.Sprintf("cursor query param is invalid: %s", err),
 			}
 		}
 
-		availableShifts, err := uc.GetAvailableShifts(r.Context(), worker.GetAvailableShiftsRequest{
-			Cursor:   queryCursor,
-			End:      *end,
-			Limit:    limit,
-			Start:    *start,
-			WorkerID: workerID,
-		})
+		eligibleWorkers, err := uc.GetEligibleWorkers(r.Context(), req)
+		if err != nil {
+			return newUseCaseError(err, "failed to get eligible workers")
+		}
+
+		nextCursor, err := encodeCursor(codec, eligibleWorkers.NextCursor, cursorScope)
+		if err != nil {
+			return &Error{
+				Code:    http.StatusInternalServerError,
+				Error:   err,
+				Message: "failed to encode next cursor",
+			}
+		}
+
+		prevCursor, err := encodeCursor(codec, eligibleWorkers.PrevCursor, cursorScope)
 		if err != nil {
 			return &Error{
 				Code:    http.StatusInternalServerError,
 				Error:   err,
-				Message: "failed to get available shifts",
+				Message: "failed to encode previous cursor",
 			}
 		}
 
 		w.Header().Set("Content-Type", "application/json")
 
-		resp := GetAvailableShiftsFromUserReponse{
-			NextCursor: availableShifts.NextCursor,
+		resp := GetEligibleWorkersForShiftResponse{
+			NextCursor: nextCursor,
+			PrevCursor: prevCursor,
+			Workers:    []Worker{},
 		}
 
-		for _, s := range availableShifts.Shifts {
-			resp.Shifts = append(resp.Shifts, AvailableShift{
-				End: s.End,
-				Facility: Facility{
-					ID:   s.Facility.ID,
-					Name: s.Facility.Name,
-				},
-				ID:    s.ID,
-				Start: s.Start,
-			})
+		for _, ew := range eligibleWorkers.Workers {
+			resp.Workers = append(resp.Workers, newWorker(ew))
 		}
 
 		if err := json.NewEncoder(w).Encode(resp); err != nil {
@@ -171,6 +1438,245 @@ func GetAvailableShiftsFromWorker(uc worker.AvailableShifts) HandlerFunc {
 	}
 }
 
+func decodeFacilityRequirementRequest(r *http.Request) (*FacilityRequirementRequest, *Error) {
+	var body FacilityRequirementRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, &Error{
+			Code:    http.StatusBadRequest,
+			Error:   err,
+			Message: "request body is invalid",
+		}
+	}
+
+	if body.DocumentID <= 0 {
+		return nil, &Error{
+			Code:    http.StatusBadRequest,
+			Error:   errors.New("document_id is required"),
+			Message: "document_id is invalid",
+		}
+	}
+
+	if body.Profession != nil && !body.Profession.Valid() {
+		return nil, &Error{
+			Code:    http.StatusBadRequest,
+			Error:   fmt.Errorf("unknown profession %q", *body.Profession),
+			Message: "profession is invalid",
+		}
+	}
+
+	return &body, nil
+}
+
+// availableShiftsCursorScope identifies the query the cursors of available
+// shifts are issued for, so they can't be used with any other query. Only the
+// parameters that change which shifts are listed, or in which order, are part
+// of it.
+func availableShiftsCursorScope(req worker.GetAvailableShiftsRequest) string {
+	sortBy := req.Sort
+	if sortBy == "" {
+		sortBy = domain.ShiftSortStart
+	}
+
+	facilityIDs := make([]int64, len(req.FacilityIDs))
+	copy(facilityIDs, req.FacilityIDs)
+	sort.Slice(facilityIDs, func(i, j int) bool { return facilityIDs[i] < facilityIDs[j] })
+
+	timeOfDay := func(t *civil.Time) string {
+		if t == nil {
+			return ""
+		}
+
+		return t.String()
+	}
+
+	return fmt.Sprintf(
+		"available_shifts:%d:%s:%s:%s:%v:%s:%s:%s:%s",
+		req.WorkerID,
+		req.Start,
+		req.End,
+		sortBy,
+		facilityIDs,
+		timeOfDay(req.StartTimeFrom),
+		timeOfDay(req.StartTimeTo),
+		req.MinDuration,
+		req.MaxDuration,
+	)
+}
+
+func encodeCursor(codec *cursor.Codec, c *cursor.Cursor, scope string) (*string, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	encoded, err := codec.Encode(c, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encoded, nil
+}
+
+// newUseCaseError maps the domain errors a use case may return to their HTTP
+// counterparts, falling back to an internal server error with the given message.
+func newUseCaseError(err error, message string) *Error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidCursor),
+		errors.Is(err, domain.ErrInvalidDateWindow),
+		errors.Is(err, domain.ErrInvalidFacilityStatus),
+		errors.Is(err, domain.ErrInvalidShift),
+		errors.Is(err, domain.ErrInvalidShiftFilter),
+		errors.Is(err, domain.ErrInvalidShiftTemplate),
+		errors.Is(err, domain.ErrInvalidWorker):
+		return &Error{Code: http.StatusBadRequest, Error: err, Message: err.Error()}
+	case errors.Is(err, domain.ErrFacilityNotFound),
+		errors.Is(err, domain.ErrRequirementNotFound),
+		errors.Is(err, domain.ErrShiftNotFound),
+		errors.Is(err, domain.ErrShiftTemplateNotFound),
+		errors.Is(err, domain.ErrWorkerDocumentNotFound),
+		errors.Is(err, domain.ErrWorkerNotFound):
+		return &Error{Code: http.StatusNotFound, Error: err, Message: err.Error()}
+	case errors.Is(err, domain.ErrDocumentAlreadyHeld),
+		errors.Is(err, domain.ErrRequirementAlreadyExists),
+		errors.Is(err, domain.ErrShiftAlreadyClaimed),
+		errors.Is(err, domain.ErrShiftNotClaimedByWorker):
+		return &Error{Code: http.StatusConflict, Error: err, Message: err.Error()}
+	case errors.Is(err, domain.ErrWorkerNotEligible),
+		errors.Is(err, domain.ErrCancellationCutoff),
+		errors.Is(err, domain.ErrDocumentExpired),
+		errors.Is(err, domain.ErrDocumentInactive),
+		errors.Is(err, domain.ErrDocumentNotFound),
+		errors.Is(err, domain.ErrFacilityInactive):
+		return &Error{Code: http.StatusUnprocessableEntity, Error: err, Message: err.Error()}
+	default:
+		return &Error{Code: http.StatusInternalServerError, Error: err, Message: message}
+	}
+}
+
+func newAvailableShift(s domain.Shift) AvailableShift {
+	return AvailableShift{
+		End:      s.End,
+		Facility: newFacility(s.Facility),
+		ID:       s.ID,
+		Start:    s.Start,
+	}
+}
+
+func newFacilityStatusChange(c domain.FacilityStatusChange) FacilityStatusChange {
+	return FacilityStatusChange{
+		Action:          string(c.Action),
+		CancelledClaims: c.CancelledClaims,
+		ChangedAt:       c.ChangedAt,
+		ID:              c.ID,
+		SuspendedUntil:  c.SuspendedUntil,
+	}
+}
+
+func newWorker(w domain.Worker) Worker {
+	return Worker{
+		ID:         w.ID,
+		IsActive:   w.IsActive,
+		Name:       w.Name,
+		Profession: string(w.Profession),
+	}
+}
+
+func newFacility(f domain.Facility) Facility {
+	return Facility{
+		ID:       f.ID,
+		Name:     f.Name,
+		TimeZone: f.TimeZone.String(),
+	}
+}
+
+func newFacilityShift(fs domain.FacilityShift) FacilityShift {
+	return FacilityShift{
+		End:        fs.Shift.End,
+		Facility:   newFacility(fs.Shift.Facility),
+		ID:         fs.Shift.ID,
+		Profession: string(fs.Profession),
+		Start:      fs.Shift.Start,
+		WorkerID:   fs.WorkerID,
+	}
+}
+
+func newShiftTemplate(st domain.ShiftTemplate) ShiftTemplate {
+	template := ShiftTemplate{
+		Count:      st.Count,
+		DaysOfWeek: []string{},
+		Duration:   st.Duration.String(),
+		Headcount:  st.Headcount,
+		ID:         st.ID,
+		Profession: string(st.Profession),
+		StartTime:  st.StartTime.String(),
+		StartsOn:   st.StartsOn,
+	}
+
+	for _, day := range st.DaysOfWeek {
+		template.DaysOfWeek = append(template.DaysOfWeek, dayOfWeekCodes[day])
+	}
+
+	return template
+}
+
+func newFacilityRequirement(fr domain.FacilityRequirement) FacilityRequirement {
+	requirement := FacilityRequirement{
+		Document: Document{
+			ID:       fr.Document.ID,
+			IsActive: fr.Document.IsActive,
+			Name:     fr.Document.Name,
+		},
+		ID: fr.ID,
+	}
+
+	if fr.Profession != nil {
+		profession := string(*fr.Profession)
+		requirement.Profession = &profession
+	}
+
+	return requirement
+}
+
+func newFacilityRequirementChangeResponse(change domain.RequirementChange, dryRun bool) FacilityRequirementChangeResponse {
+	resp := FacilityRequirementChangeResponse{
+		DryRun:      dryRun,
+		Impact:      []EligibilityImpact{},
+		Requirement: newFacilityRequirement(change.Requirement),
+	}
+
+	for _, i := range change.Impact {
+		resp.Impact = append(resp.Impact, EligibilityImpact{
+			Gained:     i.Gained,
+			Lost:       i.Lost,
+			Profession: string(i.Profession),
+		})
+	}
+
+	return resp
+}
+
+func newWorkerDocument(wd domain.WorkerDocument) WorkerDocument {
+	return WorkerDocument{
+		Document: Document{
+			ID:       wd.Document.ID,
+			IsActive: wd.Document.IsActive,
+			Name:     wd.Document.Name,
+		},
+		ExpiresAt: wd.ExpiresAt,
+		ID:        wd.ID,
+		Status:    strings.ToLower(string(wd.Status)),
+	}
+}
+
+func newAvailableShifts(shifts domain.Shifts) []AvailableShift {
+	var availableShifts []AvailableShift
+
+	for _, s := range shifts {
+		availableShifts = append(availableShifts, newAvailableShift(s))
+	}
+
+	return availableShifts
+}
+
 func parseDate(dateStr string) (*civil.Date, error) {
 	var date civil.Date
 
@@ -203,6 +1709,69 @@ func parseLimit(limitStr string) (int, error) {
 	return limit, nil
 }
 
+func parseFacilityIDs(facilityIDStrs []string) ([]int64, error) {
+	var facilityIDs []int64
+
+	for _, facilityIDStr := range facilityIDStrs {
+		facilityID, err := strconv.ParseInt(facilityIDStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		facilityIDs = append(facilityIDs, facilityID)
+	}
+
+	return facilityIDs, nil
+}
+
+// parseTimeOfDay parses times of day either with or without seconds, like
+// 20:00 or 20:00:00.
+func parseTimeOfDay(timeStr string) (*civil.Time, error) {
+	if timeStr == "" {
+		return nil, nil
+	}
+
+	if strings.Count(timeStr, ":") == 1 {
+		timeStr += ":00"
+	}
+
+	t, err := civil.ParseTime(timeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func parseDuration(durationStr string) (time.Duration, error) {
+	if durationStr == "" {
+		return 0, nil
+	}
+
+	return time.ParseDuration(durationStr)
+}
+
+func parseGroupBy(groupByStr string) (worker.GroupBy, error) {
+	groupBy := worker.GroupBy(groupByStr)
+
+	if groupBy != worker.GroupByNone && groupBy != worker.GroupByDate {
+		return "", fmt.Errorf("unknown group_by value %q", groupByStr)
+	}
+
+	return groupBy, nil
+}
+
+func parseShiftSort(sortStr string) (domain.ShiftSort, error) {
+	sort := domain.ShiftSort(sortStr)
+
+	switch sort {
+	case "", domain.ShiftSortEnd, domain.ShiftSortFacility, domain.ShiftSortStart:
+		return sort, nil
+	default:
+		return "", fmt.Errorf("unknown sort value %q", sortStr)
+	}
+}
+
 func parseWorkerID(workerIDStr string) (int64, error) {
 	workerID, err := strconv.ParseInt(workerIDStr, 10, 64)
 	if err != nil {
@@ -212,6 +1781,99 @@ func parseWorkerID(workerIDStr string) (int64, error) {
 	return workerID, nil
 }
 
+func parseShiftID(shiftIDStr string) (int64, error) {
+	shiftID, err := strconv.ParseInt(shiftIDStr, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return shiftID, nil
+}
+
+func parseDocumentID(documentIDStr string) (int64, error) {
+	documentID, err := strconv.ParseInt(documentIDStr, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return documentID, nil
+}
+
+func parseFacilityID(facilityIDStr string) (int64, error) {
+	facilityID, err := strconv.ParseInt(facilityIDStr, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return facilityID, nil
+}
+
+func parseRequirementID(requirementIDStr string) (int64, error) {
+	requirementID, err := strconv.ParseInt(requirementIDStr, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return requirementID, nil
+}
+
+func parseTemplateID(templateIDStr string) (int64, error) {
+	templateID, err := strconv.ParseInt(templateIDStr, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return templateID, nil
+}
+
+// dayOfWeekCodes are the two letter codes recurrence rules name the days of
+// the week by, like MO for Monday.
+var dayOfWeekCodes = map[time.Weekday]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+func parseDaysOfWeek(codes []string) ([]time.Weekday, error) {
+	var daysOfWeek []time.Weekday
+
+	for _, code := range codes {
+		day, ok := parseDayOfWeek(code)
+		if !ok {
+			return nil, fmt.Errorf("unknown day of the week %q", code)
+		}
+
+		daysOfWeek = append(daysOfWeek, day)
+	}
+
+	return daysOfWeek, nil
+}
+
+func parseDayOfWeek(code string) (time.Weekday, bool) {
+	for day, c := range dayOfWeekCodes {
+		if strings.EqualFold(c, code) {
+			return day, true
+		}
+	}
+
+	return 0, false
+}
+
+// parseBoolQuery parses the boolean query param of the given name, which is
+// false when not given.
+func parseBoolQuery(r *http.Request, name string) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
 func Healthcheck(conn postgres.Conn) HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) *Error {
 		if err := conn.Ping(r.Context()); err != nil {
@@ -2,15 +2,21 @@ package server_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
 	"github.com/rodrigosdo/facilities-api/internal/domain"
 	"github.com/rodrigosdo/facilities-api/internal/postgres"
 	"github.com/rodrigosdo/facilities-api/internal/server"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/document"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/shift"
 	"github.com/rodrigosdo/facilities-api/internal/usecase/worker"
 
 	"cloud.google.com/go/civil"
@@ -22,6 +28,11 @@ import (
 func TestGetAvailableShiftsFromUser(t *testing.T) {
 	t.Parallel()
 
+	fakeCodec, err := cursor.NewCodec([]byte("secret"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	fakeStartDate := civil.Date{Year: 2023, Month: 06, Day: 04}
 	fakeEndDate := civil.Date{Year: 2023, Month: 06, Day: 10}
 	fakeWorkerID := int64(123123)
@@ -50,7 +61,7 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 			Times(1).
 			Return(&worker.GetAvailableShiftsResponse{Shifts: fakeShifts}, nil)
 
-		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase)
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
 
 		rt := httprouter.New()
 		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
@@ -66,6 +77,53 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rr.Code)
 	})
 
+	t.Run("should return shift times with the offset of their facility's time zone", func(t *testing.T) {
+		t.Parallel()
+
+		newYork, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		start := time.Date(2023, time.June, 4, 5, 0, 0, 0, newYork)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAvailableShiftsUseCase := worker.NewMockAvailableShifts(ctrl)
+		mockAvailableShiftsUseCase.
+			EXPECT().
+			GetAvailableShifts(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(&worker.GetAvailableShiftsResponse{
+				Shifts: domain.Shifts{
+					{
+						End:      start.Add(5 * time.Hour),
+						Facility: domain.Facility{ID: 1, Name: "Facility", TimeZone: newYork},
+						ID:       123,
+						Start:    start,
+					},
+				},
+			}, nil)
+
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/available_shifts", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"start":"2023-06-04T05:00:00-04:00"`)
+		assert.Contains(t, rr.Body.String(), `"time_zone":"America/New_York"`)
+	})
+
 	t.Run("should successfully return even when there's no available shifts", func(t *testing.T) {
 		t.Parallel()
 
@@ -83,7 +141,7 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 			Times(1).
 			Return(&worker.GetAvailableShiftsResponse{}, nil)
 
-		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase)
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
 
 		rt := httprouter.New()
 		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
@@ -99,12 +157,191 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rr.Code)
 	})
 
-	t.Run("should return error when given an invalid cursor", func(t *testing.T) {
+	t.Run("should successfully return available shifts grouped by date", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAvailableShiftsUseCase := worker.NewMockAvailableShifts(ctrl)
+		mockAvailableShiftsUseCase.
+			EXPECT().
+			GetAvailableShifts(gomock.Any(), worker.GetAvailableShiftsRequest{
+				End:      fakeEndDate,
+				GroupBy:  worker.GroupByDate,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			}).
+			Times(1).
+			Return(&worker.GetAvailableShiftsResponse{
+				Dates: []worker.DateShifts{
+					{Date: fakeStartDate, Shifts: fakeShifts},
+				},
+				Shifts: fakeShifts,
+			}, nil)
+
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/available_shifts?end=2023-06-10&start=2023-06-04&group_by=date", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"date":"2023-06-04"`)
+	})
+
+	t.Run("should return error when given an invalid group by", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.GetAvailableShiftsFromWorker(nil, fakeCodec)
+
+		req, err := http.NewRequest("GET", "?group_by=invalid", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should pass the given sort along", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAvailableShiftsUseCase := worker.NewMockAvailableShifts(ctrl)
+		mockAvailableShiftsUseCase.
+			EXPECT().
+			GetAvailableShifts(gomock.Any(), worker.GetAvailableShiftsRequest{
+				End:      fakeEndDate,
+				Sort:     domain.ShiftSortFacility,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			}).
+			Times(1).
+			Return(&worker.GetAvailableShiftsResponse{Shifts: fakeShifts}, nil)
+
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/available_shifts?end=2023-06-10&start=2023-06-04&sort=facility", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should pass the given filters along", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAvailableShiftsUseCase := worker.NewMockAvailableShifts(ctrl)
+		mockAvailableShiftsUseCase.
+			EXPECT().
+			GetAvailableShifts(gomock.Any(), worker.GetAvailableShiftsRequest{
+				FacilityIDs:   []int64{1, 2},
+				MaxDuration:   8 * time.Hour,
+				MinDuration:   90 * time.Minute,
+				StartTimeFrom: &civil.Time{Hour: 20},
+				StartTimeTo:   &civil.Time{Hour: 4, Minute: 30},
+				WorkerID:      fakeWorkerID,
+			}).
+			Times(1).
+			Return(&worker.GetAvailableShiftsResponse{Shifts: fakeShifts}, nil)
+
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/available_shifts?facility_id=1&facility_id=2&start_time_from=20:00&start_time_to=04:30:00&min_duration=1h30m&max_duration=8h", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should return error when given invalid filters", func(t *testing.T) {
+		t.Parallel()
+
+		for _, query := range []string{
+			"facility_id=invalid",
+			"start_time_from=8pm",
+			"start_time_to=25:00",
+			"min_duration=4",
+			"max_duration=invalid",
+		} {
+			handler := server.GetAvailableShiftsFromWorker(nil, fakeCodec)
+
+			req, err := http.NewRequest("GET", "?"+query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+
+	t.Run("should return error when the use case rejects the filters", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAvailableShiftsUseCase := worker.NewMockAvailableShifts(ctrl)
+		mockAvailableShiftsUseCase.
+			EXPECT().
+			GetAvailableShifts(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrInvalidShiftFilter)
+
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/available_shifts?min_duration=8h&max_duration=4h", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return error when given an invalid sort", func(t *testing.T) {
 		t.Parallel()
 
-		handler := server.GetAvailableShiftsFromWorker(nil)
+		handler := server.GetAvailableShiftsFromWorker(nil, fakeCodec)
 
-		req, err := http.NewRequest("GET", "?cursor=invalid", nil)
+		req, err := http.NewRequest("GET", "?sort=invalid", nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -115,10 +352,128 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
 	})
 
+	t.Run("should return error when the cursor does not match the sort", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAvailableShiftsUseCase := worker.NewMockAvailableShifts(ctrl)
+		mockAvailableShiftsUseCase.
+			EXPECT().
+			GetAvailableShifts(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrInvalidCursor)
+
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/available_shifts?sort=end", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return error when given an invalid cursor", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.GetAvailableShiftsFromWorker(nil, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/available_shifts?cursor=invalid", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), cursor.ErrMalformed.Error())
+	})
+
+	t.Run("should accept the returned cursors only for the same query", func(t *testing.T) {
+		t.Parallel()
+
+		fakeNextCursor := cursor.New(cursor.DirectionAfter, "2023-06-04T05:00:00Z", "123")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAvailableShiftsUseCase := worker.NewMockAvailableShifts(ctrl)
+		mockAvailableShiftsUseCase.
+			EXPECT().
+			GetAvailableShifts(gomock.Any(), worker.GetAvailableShiftsRequest{
+				End:      fakeEndDate,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			}).
+			Times(1).
+			Return(&worker.GetAvailableShiftsResponse{NextCursor: fakeNextCursor, Shifts: fakeShifts}, nil)
+		mockAvailableShiftsUseCase.
+			EXPECT().
+			GetAvailableShifts(gomock.Any(), worker.GetAvailableShiftsRequest{
+				Cursor:   fakeNextCursor,
+				End:      fakeEndDate,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			}).
+			Times(1).
+			Return(&worker.GetAvailableShiftsResponse{Shifts: fakeShifts}, nil)
+
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
+
+		get := func(url string) *httptest.ResponseRecorder {
+			req, err := http.NewRequest("GET", url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			rt.ServeHTTP(rr, req)
+
+			return rr
+		}
+
+		rr := get("/v1/workers/123123/available_shifts?end=2023-06-10&start=2023-06-04")
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp server.GetAvailableShiftsFromUserReponse
+		if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+
+		if !assert.NotNil(t, resp.NextCursor) {
+			return
+		}
+		assert.Nil(t, resp.PrevCursor)
+
+		rr = get("/v1/workers/123123/available_shifts?end=2023-06-10&start=2023-06-04&cursor=" + *resp.NextCursor)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		rr = get("/v1/workers/321/available_shifts?end=2023-06-10&start=2023-06-04&cursor=" + *resp.NextCursor)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = get("/v1/workers/123123/available_shifts?end=2023-06-10&start=2023-06-04&sort=end&cursor=" + *resp.NextCursor)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
 	t.Run("should return error when given an invalid start date", func(t *testing.T) {
 		t.Parallel()
 
-		handler := server.GetAvailableShiftsFromWorker(nil)
+		handler := server.GetAvailableShiftsFromWorker(nil, fakeCodec)
 
 		req, err := http.NewRequest("GET", "?start=invalid", nil)
 		if err != nil {
@@ -134,7 +489,7 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 	t.Run("should return error when given an invalid end date", func(t *testing.T) {
 		t.Parallel()
 
-		handler := server.GetAvailableShiftsFromWorker(nil)
+		handler := server.GetAvailableShiftsFromWorker(nil, fakeCodec)
 
 		req, err := http.NewRequest("GET", "?end=invalid", nil)
 		if err != nil {
@@ -150,7 +505,7 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 	t.Run("should return error when given an invalid limit", func(t *testing.T) {
 		t.Parallel()
 
-		handler := server.GetAvailableShiftsFromWorker(nil)
+		handler := server.GetAvailableShiftsFromWorker(nil, fakeCodec)
 
 		req, err := http.NewRequest("GET", "?limit=invalid", nil)
 		if err != nil {
@@ -166,7 +521,7 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 	t.Run("should return error when given an worker id", func(t *testing.T) {
 		t.Parallel()
 
-		handler := server.GetAvailableShiftsFromWorker(nil)
+		handler := server.GetAvailableShiftsFromWorker(nil, fakeCodec)
 
 		req, err := http.NewRequest("GET", "", nil)
 		if err != nil {
@@ -196,7 +551,7 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 			Times(1).
 			Return(nil, errors.New("error"))
 
-		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase)
+		handler := server.GetAvailableShiftsFromWorker(mockAvailableShiftsUseCase, fakeCodec)
 
 		rt := httprouter.New()
 		rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", server.HandlerFunc(handler))
@@ -213,6 +568,2077 @@ func TestGetAvailableShiftsFromUser(t *testing.T) {
 	})
 }
 
+func TestClaimShiftFromWorker(t *testing.T) {
+	t.Parallel()
+
+	fakeShiftID := int64(321)
+	fakeWorkerID := int64(123123)
+	fakeShift := domain.Shift{
+		End:   time.Time{},
+		ID:    fakeShiftID,
+		Start: time.Time{},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		response     *worker.ClaimShiftResponse
+		err          error
+		expectedCode int
+	}{
+		{
+			name:         "should successfully claim the shift",
+			response:     &worker.ClaimShiftResponse{Shift: fakeShift},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "should return conflict when the shift is already claimed",
+			err:          domain.ErrShiftAlreadyClaimed,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "should return not found when the shift does not exist",
+			err:          domain.ErrShiftNotFound,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "should return unprocessable entity when the worker is not eligible",
+			err:          domain.ErrWorkerNotEligible,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "should return error if fails to claim the shift",
+			err:          errors.New("error"),
+			expectedCode: http.StatusInternalServerError,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClaimShiftUseCase := worker.NewMockClaimShift(ctrl)
+			mockClaimShiftUseCase.
+				EXPECT().
+				ClaimShift(gomock.Any(), worker.ClaimShiftRequest{
+					ShiftID:  fakeShiftID,
+					WorkerID: fakeWorkerID,
+				}).
+				Times(1).
+				Return(tc.response, tc.err)
+
+			handler := server.ClaimShiftFromWorker(mockClaimShiftUseCase)
+
+			rt := httprouter.New()
+			rt.Handler(http.MethodPost, "/v1/workers/:id/shifts/:shift_id/claim", server.HandlerFunc(handler))
+
+			req, err := http.NewRequest("POST", "/v1/workers/123123/shifts/321/claim", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			rt.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+		})
+	}
+
+	t.Run("should return error when given an invalid worker id", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.ClaimShiftFromWorker(nil)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/workers/:id/shifts/:shift_id/claim", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/workers/invalid/shifts/321/claim", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return error when given an invalid shift id", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.ClaimShiftFromWorker(nil)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/workers/:id/shifts/:shift_id/claim", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/workers/123123/shifts/invalid/claim", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestCancelShiftFromWorker(t *testing.T) {
+	t.Parallel()
+
+	fakeShiftID := int64(321)
+	fakeWorkerID := int64(123123)
+	fakeShift := domain.Shift{
+		End:   time.Time{},
+		ID:    fakeShiftID,
+		Start: time.Time{},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		response     *worker.CancelShiftResponse
+		err          error
+		expectedCode int
+	}{
+		{
+			name:         "should successfully cancel the shift",
+			response:     &worker.CancelShiftResponse{Shift: fakeShift},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "should return conflict when the shift is not claimed by the worker",
+			err:          domain.ErrShiftNotClaimedByWorker,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "should return not found when the shift does not exist",
+			err:          domain.ErrShiftNotFound,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "should return unprocessable entity when past the cancellation cutoff",
+			err:          domain.ErrCancellationCutoff,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "should return error if fails to cancel the shift",
+			err:          errors.New("error"),
+			expectedCode: http.StatusInternalServerError,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCancelShiftUseCase := worker.NewMockCancelShift(ctrl)
+			mockCancelShiftUseCase.
+				EXPECT().
+				CancelShift(gomock.Any(), worker.CancelShiftRequest{
+					ShiftID:  fakeShiftID,
+					WorkerID: fakeWorkerID,
+				}).
+				Times(1).
+				Return(tc.response, tc.err)
+
+			handler := server.CancelShiftFromWorker(mockCancelShiftUseCase)
+
+			rt := httprouter.New()
+			rt.Handler(http.MethodPost, "/v1/workers/:id/shifts/:shift_id/cancel", server.HandlerFunc(handler))
+
+			req, err := http.NewRequest("POST", "/v1/workers/123123/shifts/321/cancel", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			rt.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+		})
+	}
+
+	t.Run("should return error when given an invalid shift id", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.CancelShiftFromWorker(nil)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/workers/:id/shifts/:shift_id/cancel", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/workers/123123/shifts/invalid/cancel", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetShiftEligibilityFromWorker(t *testing.T) {
+	t.Parallel()
+
+	fakeShiftID := int64(321)
+	fakeWorkerID := int64(123123)
+
+	t.Run("should successfully return the failed checks", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShiftEligibilityUseCase := worker.NewMockShiftEligibility(ctrl)
+		mockShiftEligibilityUseCase.
+			EXPECT().
+			GetShiftEligibility(gomock.Any(), worker.GetShiftEligibilityRequest{
+				ShiftID:  fakeShiftID,
+				WorkerID: fakeWorkerID,
+			}).
+			Times(1).
+			Return(&worker.GetShiftEligibilityResponse{
+				Eligibility: domain.Eligibility{
+					Checks: []domain.EligibilityCheck{
+						{Passed: true, Rule: domain.EligibilityRuleWorkerActive},
+						{Passed: false, Rule: domain.EligibilityRuleDocumentsHeld},
+					},
+					MissingDocumentIDs: []int64{4},
+					ShiftID:            fakeShiftID,
+					WorkerID:           fakeWorkerID,
+				},
+			}, nil)
+
+		handler := server.GetShiftEligibilityFromWorker(mockShiftEligibilityUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/shifts/:shift_id/eligibility", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/shifts/321/eligibility", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": {
+				"checks": [
+					{"passed": true, "rule": "worker_active"},
+					{"passed": false, "rule": "documents_held"}
+				],
+				"eligible": false,
+				"missing_document_ids": [4],
+				"shift_id": 321,
+				"worker_id": 123123
+			}
+		}`, rr.Body.String())
+	})
+
+	t.Run("should return not found when the worker does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShiftEligibilityUseCase := worker.NewMockShiftEligibility(ctrl)
+		mockShiftEligibilityUseCase.
+			EXPECT().
+			GetShiftEligibility(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrWorkerNotFound)
+
+		handler := server.GetShiftEligibilityFromWorker(mockShiftEligibilityUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/shifts/:shift_id/eligibility", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/shifts/321/eligibility", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should return error when given an invalid shift id", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.GetShiftEligibilityFromWorker(nil)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/shifts/:shift_id/eligibility", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/shifts/invalid/eligibility", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetWorkerDocuments(t *testing.T) {
+	t.Parallel()
+
+	fakeWorkerID := int64(123123)
+
+	t.Run("should successfully return the documents of the worker", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		mockWorkerDocumentsUseCase := document.NewMockWorkerDocuments(ctrl)
+		mockWorkerDocumentsUseCase.
+			EXPECT().
+			GetWorkerDocuments(gomock.Any(), document.GetWorkerDocumentsRequest{
+				WorkerID: fakeWorkerID,
+			}).
+			Times(1).
+			Return(&document.GetWorkerDocumentsResponse{
+				WorkerDocuments: []domain.WorkerDocument{
+					{
+						Document:  domain.Document{ID: 4, IsActive: true, Name: "License"},
+						ExpiresAt: &expiresAt,
+						ID:        10,
+						Status:    domain.DocumentStatusVerified,
+						WorkerID:  fakeWorkerID,
+					},
+				},
+			}, nil)
+
+		handler := server.GetWorkerDocuments(mockWorkerDocumentsUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/documents", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/documents", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": [
+				{
+					"document": {"id": 4, "is_active": true, "name": "License"},
+					"expires_at": "2030-01-01T00:00:00Z",
+					"id": 10,
+					"status": "verified"
+				}
+			]
+		}`, rr.Body.String())
+	})
+
+	t.Run("should return not found when the worker does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerDocumentsUseCase := document.NewMockWorkerDocuments(ctrl)
+		mockWorkerDocumentsUseCase.
+			EXPECT().
+			GetWorkerDocuments(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrWorkerNotFound)
+
+		handler := server.GetWorkerDocuments(mockWorkerDocumentsUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id/documents", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/123123/documents", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestAddWorkerDocument(t *testing.T) {
+	t.Parallel()
+
+	fakeDocumentID := int64(4)
+	fakeWorkerID := int64(123123)
+
+	t.Run("should successfully add the document and return the newly eligible facilities", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		newYork, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mockAddWorkerDocumentUseCase := document.NewMockAddWorkerDocument(ctrl)
+		mockAddWorkerDocumentUseCase.
+			EXPECT().
+			AddWorkerDocument(gomock.Any(), document.AddWorkerDocumentRequest{
+				DocumentID: fakeDocumentID,
+				WorkerID:   fakeWorkerID,
+			}).
+			Times(1).
+			Return(&document.AddWorkerDocumentResponse{
+				NewlyEligibleFacilities: []domain.Facility{
+					{ID: 7, Name: "Facility", TimeZone: newYork},
+				},
+				WorkerDocument: domain.WorkerDocument{
+					Document: domain.Document{ID: fakeDocumentID, IsActive: true, Name: "License"},
+					ID:       10,
+					Status:   domain.DocumentStatusPending,
+					WorkerID: fakeWorkerID,
+				},
+			}, nil)
+
+		handler := server.AddWorkerDocument(mockAddWorkerDocumentUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/workers/:id/documents", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/workers/123123/documents", strings.NewReader(`{"document_id": 4}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.JSONEq(t, `{
+			"data": {
+				"document": {"id": 4, "is_active": true, "name": "License"},
+				"expires_at": null,
+				"id": 10,
+				"status": "pending"
+			},
+			"newly_eligible_facilities": [
+				{"id": 7, "name": "Facility", "time_zone": "America/New_York"}
+			]
+		}`, rr.Body.String())
+	})
+
+	t.Run("should pass the expiry of the document", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		mockAddWorkerDocumentUseCase := document.NewMockAddWorkerDocument(ctrl)
+		mockAddWorkerDocumentUseCase.
+			EXPECT().
+			AddWorkerDocument(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, req document.AddWorkerDocumentRequest) (*document.AddWorkerDocumentResponse, error) {
+				assert.True(t, expiresAt.Equal(*req.ExpiresAt))
+
+				return &document.AddWorkerDocumentResponse{}, nil
+			})
+
+		handler := server.AddWorkerDocument(mockAddWorkerDocumentUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/workers/:id/documents", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/workers/123123/documents", strings.NewReader(`{"document_id": 4, "expires_at": "2030-01-01T00:00:00Z"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("should return error when given an invalid body", func(t *testing.T) {
+		t.Parallel()
+
+		for _, body := range []string{`{"document_id": "4"}`, `{}`, `{"document_id": 4, "expires_at": "2030-01-01"}`} {
+			handler := server.AddWorkerDocument(nil)
+
+			rt := httprouter.New()
+			rt.Handler(http.MethodPost, "/v1/workers/:id/documents", server.HandlerFunc(handler))
+
+			req, err := http.NewRequest("POST", "/v1/workers/123123/documents", strings.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			rt.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code, body)
+		}
+	})
+
+	t.Run("should map document errors to their status codes", func(t *testing.T) {
+		t.Parallel()
+
+		for err, code := range map[error]int{
+			domain.ErrDocumentAlreadyHeld: http.StatusConflict,
+			domain.ErrDocumentExpired:     http.StatusUnprocessableEntity,
+			domain.ErrDocumentInactive:    http.StatusUnprocessableEntity,
+			domain.ErrDocumentNotFound:    http.StatusUnprocessableEntity,
+			domain.ErrWorkerNotFound:      http.StatusNotFound,
+		} {
+			ctrl := gomock.NewController(t)
+
+			mockAddWorkerDocumentUseCase := document.NewMockAddWorkerDocument(ctrl)
+			mockAddWorkerDocumentUseCase.
+				EXPECT().
+				AddWorkerDocument(gomock.Any(), gomock.Any()).
+				Times(1).
+				Return(nil, err)
+
+			handler := server.AddWorkerDocument(mockAddWorkerDocumentUseCase)
+
+			rt := httprouter.New()
+			rt.Handler(http.MethodPost, "/v1/workers/:id/documents", server.HandlerFunc(handler))
+
+			req, reqErr := http.NewRequest("POST", "/v1/workers/123123/documents", strings.NewReader(`{"document_id": 4}`))
+			if reqErr != nil {
+				t.Fatal(reqErr)
+			}
+
+			rr := httptest.NewRecorder()
+			rt.ServeHTTP(rr, req)
+
+			assert.Equal(t, code, rr.Code, err.Error())
+
+			ctrl.Finish()
+		}
+	})
+}
+
+func TestRemoveWorkerDocument(t *testing.T) {
+	t.Parallel()
+
+	fakeDocumentID := int64(4)
+	fakeWorkerID := int64(123123)
+
+	t.Run("should successfully remove the document", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRemoveWorkerDocumentUseCase := document.NewMockRemoveWorkerDocument(ctrl)
+		mockRemoveWorkerDocumentUseCase.
+			EXPECT().
+			RemoveWorkerDocument(gomock.Any(), document.RemoveWorkerDocumentRequest{
+				DocumentID: fakeDocumentID,
+				WorkerID:   fakeWorkerID,
+			}).
+			Times(1).
+			Return(nil)
+
+		handler := server.RemoveWorkerDocument(mockRemoveWorkerDocumentUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodDelete, "/v1/workers/:id/documents/:document_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("DELETE", "/v1/workers/123123/documents/4", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Empty(t, rr.Body.String())
+	})
+
+	t.Run("should return not found when the worker does not hold the document", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRemoveWorkerDocumentUseCase := document.NewMockRemoveWorkerDocument(ctrl)
+		mockRemoveWorkerDocumentUseCase.
+			EXPECT().
+			RemoveWorkerDocument(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domain.ErrWorkerDocumentNotFound)
+
+		handler := server.RemoveWorkerDocument(mockRemoveWorkerDocumentUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodDelete, "/v1/workers/:id/documents/:document_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("DELETE", "/v1/workers/123123/documents/4", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("should return error when given an invalid document id", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.RemoveWorkerDocument(nil)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodDelete, "/v1/workers/:id/documents/:document_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("DELETE", "/v1/workers/123123/documents/invalid", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetFacilityRequirements(t *testing.T) {
+	t.Parallel()
+
+	fakeFacilityID := int64(7)
+
+	t.Run("should successfully return the requirements of the facility", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRequirementsUseCase := facility.NewMockRequirements(ctrl)
+		mockRequirementsUseCase.
+			EXPECT().
+			GetRequirements(gomock.Any(), facility.GetRequirementsRequest{
+				FacilityID: fakeFacilityID,
+			}).
+			Times(1).
+			Return(&facility.GetRequirementsResponse{
+				Requirements: []domain.FacilityRequirement{
+					{
+						Document:   domain.Document{ID: 4, IsActive: true, Name: "License"},
+						FacilityID: fakeFacilityID,
+						ID:         10,
+					},
+				},
+			}, nil)
+
+		handler := server.GetFacilityRequirements(mockRequirementsUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/facilities/:id/requirements", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/facilities/7/requirements", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": [
+				{"document": {"id": 4, "is_active": true, "name": "License"}, "id": 10, "profession": null}
+			]
+		}`, rr.Body.String())
+	})
+
+	t.Run("should return not found when the facility does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRequirementsUseCase := facility.NewMockRequirements(ctrl)
+		mockRequirementsUseCase.
+			EXPECT().
+			GetRequirements(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrFacilityNotFound)
+
+		handler := server.GetFacilityRequirements(mockRequirementsUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/facilities/:id/requirements", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/facilities/7/requirements", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestAddFacilityRequirement(t *testing.T) {
+	t.Parallel()
+
+	fakeDocumentID := int64(4)
+	fakeFacilityID := int64(7)
+	fakeChange := domain.RequirementChange{
+		Impact: []domain.EligibilityImpact{
+			{Gained: 0, Lost: 3, Profession: domain.ProfessionCNA},
+		},
+		Requirement: domain.FacilityRequirement{
+			Document:   domain.Document{ID: fakeDocumentID, IsActive: true, Name: "License"},
+			FacilityID: fakeFacilityID,
+			ID:         10,
+		},
+	}
+
+	t.Run("should successfully add the requirement", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAddRequirementUseCase := facility.NewMockAddRequirement(ctrl)
+		mockAddRequirementUseCase.
+			EXPECT().
+			AddRequirement(gomock.Any(), facility.AddRequirementRequest{
+				DocumentID: fakeDocumentID,
+				FacilityID: fakeFacilityID,
+			}).
+			Times(1).
+			Return(&facility.AddRequirementResponse{Change: fakeChange}, nil)
+
+		handler := server.AddFacilityRequirement(mockAddRequirementUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/requirements", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/requirements", strings.NewReader(`{"document_id": 4}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.JSONEq(t, `{
+			"data": {"document": {"id": 4, "is_active": true, "name": "License"}, "id": 10, "profession": null},
+			"dry_run": false,
+			"impact": [{"gained": 0, "lost": 3, "profession": "CNA"}]
+		}`, rr.Body.String())
+	})
+
+	t.Run("should report the impact of a dry run", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAddRequirementUseCase := facility.NewMockAddRequirement(ctrl)
+		mockAddRequirementUseCase.
+			EXPECT().
+			AddRequirement(gomock.Any(), facility.AddRequirementRequest{
+				DocumentID: fakeDocumentID,
+				DryRun:     true,
+				FacilityID: fakeFacilityID,
+			}).
+			Times(1).
+			Return(&facility.AddRequirementResponse{Change: fakeChange}, nil)
+
+		handler := server.AddFacilityRequirement(mockAddRequirementUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/requirements", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/requirements?dry_run=true", strings.NewReader(`{"document_id": 4}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"dry_run":true`)
+	})
+
+	t.Run("should return error when given an invalid dry_run", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.AddFacilityRequirement(nil)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/requirements", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/requirements?dry_run=maybe", strings.NewReader(`{"document_id": 4}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return conflict when the document is already required", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAddRequirementUseCase := facility.NewMockAddRequirement(ctrl)
+		mockAddRequirementUseCase.
+			EXPECT().
+			AddRequirement(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrRequirementAlreadyExists)
+
+		handler := server.AddFacilityRequirement(mockAddRequirementUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/requirements", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/requirements", strings.NewReader(`{"document_id": 4}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestUpdateFacilityRequirement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should successfully update the requirement", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUpdateRequirementUseCase := facility.NewMockUpdateRequirement(ctrl)
+		mockUpdateRequirementUseCase.
+			EXPECT().
+			UpdateRequirement(gomock.Any(), facility.UpdateRequirementRequest{
+				DocumentID:    5,
+				FacilityID:    7,
+				RequirementID: 10,
+			}).
+			Times(1).
+			Return(&facility.UpdateRequirementResponse{
+				Change: domain.RequirementChange{
+					Requirement: domain.FacilityRequirement{
+						Document:   domain.Document{ID: 5, IsActive: true, Name: "Vaccination"},
+						FacilityID: 7,
+						ID:         10,
+					},
+				},
+			}, nil)
+
+		handler := server.UpdateFacilityRequirement(mockUpdateRequirementUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPut, "/v1/facilities/:id/requirements/:requirement_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("PUT", "/v1/facilities/7/requirements/10", strings.NewReader(`{"document_id": 5}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": {"document": {"id": 5, "is_active": true, "name": "Vaccination"}, "id": 10, "profession": null},
+			"dry_run": false,
+			"impact": []
+		}`, rr.Body.String())
+	})
+
+	t.Run("should update the profession of the requirement", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		profession := domain.ProfessionRN
+
+		mockUpdateRequirementUseCase := facility.NewMockUpdateRequirement(ctrl)
+		mockUpdateRequirementUseCase.
+			EXPECT().
+			UpdateRequirement(gomock.Any(), facility.UpdateRequirementRequest{
+				DocumentID:    5,
+				FacilityID:    7,
+				Profession:    &profession,
+				RequirementID: 10,
+			}).
+			Times(1).
+			Return(&facility.UpdateRequirementResponse{
+				Change: domain.RequirementChange{
+					Requirement: domain.FacilityRequirement{
+						Document:   domain.Document{ID: 5, IsActive: true, Name: "Vaccination"},
+						FacilityID: 7,
+						ID:         10,
+						Profession: &profession,
+					},
+				},
+			}, nil)
+
+		handler := server.UpdateFacilityRequirement(mockUpdateRequirementUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPut, "/v1/facilities/:id/requirements/:requirement_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("PUT", "/v1/facilities/7/requirements/10", strings.NewReader(`{"document_id": 5, "profession": "RN"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"profession":"RN"`)
+	})
+
+	t.Run("should return error when given an invalid body", func(t *testing.T) {
+		t.Parallel()
+
+		for _, body := range []string{`{}`, `{"document_id": 5, "profession": "MD"}`} {
+			handler := server.UpdateFacilityRequirement(nil)
+
+			rt := httprouter.New()
+			rt.Handler(http.MethodPut, "/v1/facilities/:id/requirements/:requirement_id", server.HandlerFunc(handler))
+
+			req, err := http.NewRequest("PUT", "/v1/facilities/7/requirements/10", strings.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			rt.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code, body)
+		}
+	})
+}
+
+func TestRemoveFacilityRequirement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should report the impact of a dry run", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRemoveRequirementUseCase := facility.NewMockRemoveRequirement(ctrl)
+		mockRemoveRequirementUseCase.
+			EXPECT().
+			RemoveRequirement(gomock.Any(), facility.RemoveRequirementRequest{
+				DryRun:        true,
+				FacilityID:    7,
+				RequirementID: 10,
+			}).
+			Times(1).
+			Return(&facility.RemoveRequirementResponse{
+				Change: domain.RequirementChange{
+					Impact: []domain.EligibilityImpact{
+						{Gained: 2, Profession: domain.ProfessionRN},
+					},
+					Requirement: domain.FacilityRequirement{
+						Document:   domain.Document{ID: 4, IsActive: true, Name: "License"},
+						FacilityID: 7,
+						ID:         10,
+					},
+				},
+			}, nil)
+
+		handler := server.RemoveFacilityRequirement(mockRemoveRequirementUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodDelete, "/v1/facilities/:id/requirements/:requirement_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("DELETE", "/v1/facilities/7/requirements/10?dry_run=true", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": {"document": {"id": 4, "is_active": true, "name": "License"}, "id": 10, "profession": null},
+			"dry_run": true,
+			"impact": [{"gained": 2, "lost": 0, "profession": "RN"}]
+		}`, rr.Body.String())
+	})
+
+	t.Run("should return not found when the requirement does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRemoveRequirementUseCase := facility.NewMockRemoveRequirement(ctrl)
+		mockRemoveRequirementUseCase.
+			EXPECT().
+			RemoveRequirement(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrRequirementNotFound)
+
+		handler := server.RemoveFacilityRequirement(mockRemoveRequirementUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodDelete, "/v1/facilities/:id/requirements/:requirement_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("DELETE", "/v1/facilities/7/requirements/10", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestCreateFacilityShift(t *testing.T) {
+	t.Parallel()
+
+	fakeStart := time.Date(2030, 1, 1, 5, 0, 0, 0, time.UTC)
+	fakeEnd := fakeStart.Add(5 * time.Hour)
+
+	t.Run("should successfully create the shift", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCreateShiftUseCase := facility.NewMockCreateShift(ctrl)
+		mockCreateShiftUseCase.
+			EXPECT().
+			CreateShift(gomock.Any(), facility.CreateShiftRequest{
+				End:        fakeEnd,
+				FacilityID: 7,
+				Profession: domain.ProfessionCNA,
+				Start:      fakeStart,
+			}).
+			Times(1).
+			Return(&facility.CreateShiftResponse{
+				Shift: domain.FacilityShift{
+					Profession: domain.ProfessionCNA,
+					Shift: domain.Shift{
+						End:      fakeEnd,
+						Facility: domain.Facility{ID: 7, Name: "Facility", TimeZone: time.UTC},
+						ID:       10,
+						Start:    fakeStart,
+					},
+				},
+			}, nil)
+
+		handler := server.CreateFacilityShift(mockCreateShiftUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/shifts", strings.NewReader(`{"start": "2030-01-01T05:00:00Z", "end": "2030-01-01T10:00:00Z", "profession": "CNA"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.JSONEq(t, `{
+			"data": {
+				"end": "2030-01-01T10:00:00Z",
+				"facility": {"id": 7, "name": "Facility", "time_zone": "UTC"},
+				"id": 10,
+				"profession": "CNA",
+				"start": "2030-01-01T05:00:00Z",
+				"worker_id": null
+			}
+		}`, rr.Body.String())
+	})
+
+	t.Run("should return bad request when the shift is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCreateShiftUseCase := facility.NewMockCreateShift(ctrl)
+		mockCreateShiftUseCase.
+			EXPECT().
+			CreateShift(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrInvalidShift)
+
+		handler := server.CreateFacilityShift(mockCreateShiftUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/shifts", strings.NewReader(`{"start": "2030-01-01T10:00:00Z", "end": "2030-01-01T05:00:00Z", "profession": "CNA"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return unprocessable entity when the facility is inactive", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCreateShiftUseCase := facility.NewMockCreateShift(ctrl)
+		mockCreateShiftUseCase.
+			EXPECT().
+			CreateShift(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrFacilityInactive)
+
+		handler := server.CreateFacilityShift(mockCreateShiftUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/shifts", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/shifts", strings.NewReader(`{"start": "2030-01-01T05:00:00Z", "end": "2030-01-01T10:00:00Z", "profession": "CNA"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
+
+func TestUpdateFacilityShift(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should successfully update the shift, unassigning the worker", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fakeStart := time.Date(2030, 1, 1, 6, 0, 0, 0, time.UTC)
+
+		mockUpdateShiftUseCase := facility.NewMockUpdateShift(ctrl)
+		mockUpdateShiftUseCase.
+			EXPECT().
+			UpdateShift(gomock.Any(), facility.UpdateShiftRequest{
+				FacilityID: 7,
+				ShiftID:    10,
+				Unassign:   true,
+				Update:     domain.ShiftUpdate{Start: &fakeStart},
+			}).
+			Times(1).
+			Return(&facility.UpdateShiftResponse{
+				Shift: domain.FacilityShift{
+					Profession: domain.ProfessionRN,
+					Shift: domain.Shift{
+						End:      fakeStart.Add(4 * time.Hour),
+						Facility: domain.Facility{ID: 7, Name: "Facility", TimeZone: time.UTC},
+						ID:       10,
+						Start:    fakeStart,
+					},
+				},
+			}, nil)
+
+		handler := server.UpdateFacilityShift(mockUpdateShiftUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPatch, "/v1/facilities/:id/shifts/:shift_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("PATCH", "/v1/facilities/7/shifts/10?unassign=true", strings.NewReader(`{"start": "2030-01-01T06:00:00Z"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": {
+				"end": "2030-01-01T10:00:00Z",
+				"facility": {"id": 7, "name": "Facility", "time_zone": "UTC"},
+				"id": 10,
+				"profession": "RN",
+				"start": "2030-01-01T06:00:00Z",
+				"worker_id": null
+			}
+		}`, rr.Body.String())
+	})
+
+	t.Run("should return conflict when the shift is claimed", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUpdateShiftUseCase := facility.NewMockUpdateShift(ctrl)
+		mockUpdateShiftUseCase.
+			EXPECT().
+			UpdateShift(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrShiftAlreadyClaimed)
+
+		handler := server.UpdateFacilityShift(mockUpdateShiftUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPatch, "/v1/facilities/:id/shifts/:shift_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("PATCH", "/v1/facilities/7/shifts/10", strings.NewReader(`{"profession": "RN"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("should return error when given an invalid unassign", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.UpdateFacilityShift(nil)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPatch, "/v1/facilities/:id/shifts/:shift_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("PATCH", "/v1/facilities/7/shifts/10?unassign=maybe", strings.NewReader(`{"profession": "RN"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestDeleteFacilityShift(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should successfully delete the shift", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fakeStart := time.Date(2030, 1, 1, 5, 0, 0, 0, time.UTC)
+
+		mockDeleteShiftUseCase := facility.NewMockDeleteShift(ctrl)
+		mockDeleteShiftUseCase.
+			EXPECT().
+			DeleteShift(gomock.Any(), facility.DeleteShiftRequest{
+				FacilityID: 7,
+				ShiftID:    10,
+			}).
+			Times(1).
+			Return(&facility.DeleteShiftResponse{
+				Shift: domain.FacilityShift{
+					Profession: domain.ProfessionLVN,
+					Shift: domain.Shift{
+						End:      fakeStart.Add(5 * time.Hour),
+						Facility: domain.Facility{ID: 7, Name: "Facility", TimeZone: time.UTC},
+						ID:       10,
+						Start:    fakeStart,
+					},
+				},
+			}, nil)
+
+		handler := server.DeleteFacilityShift(mockDeleteShiftUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodDelete, "/v1/facilities/:id/shifts/:shift_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("DELETE", "/v1/facilities/7/shifts/10", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"id":10`)
+	})
+
+	t.Run("should return not found when the shift does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockDeleteShiftUseCase := facility.NewMockDeleteShift(ctrl)
+		mockDeleteShiftUseCase.
+			EXPECT().
+			DeleteShift(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrShiftNotFound)
+
+		handler := server.DeleteFacilityShift(mockDeleteShiftUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodDelete, "/v1/facilities/:id/shifts/:shift_id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("DELETE", "/v1/facilities/7/shifts/10", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestGetShiftTemplates(t *testing.T) {
+	t.Parallel()
+
+	count := 10
+	startsOn := civil.Date{Year: 2030, Month: time.January, Day: 7}
+
+	t.Run("should successfully return the shift templates", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShiftTemplatesUseCase := facility.NewMockShiftTemplates(ctrl)
+		mockShiftTemplatesUseCase.
+			EXPECT().
+			GetShiftTemplates(gomock.Any(), facility.GetShiftTemplatesRequest{FacilityID: 7}).
+			Times(1).
+			Return(&facility.GetShiftTemplatesResponse{
+				Templates: []domain.ShiftTemplate{
+					{
+						Count:      &count,
+						DaysOfWeek: []time.Weekday{time.Monday, time.Friday},
+						Duration:   5 * time.Hour,
+						FacilityID: 7,
+						Headcount:  2,
+						ID:         3,
+						Profession: domain.ProfessionCNA,
+						StartTime:  civil.Time{Hour: 5},
+						StartsOn:   &startsOn,
+					},
+				},
+			}, nil)
+
+		handler := server.GetShiftTemplates(mockShiftTemplatesUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/facilities/:id/shift_templates", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/facilities/7/shift_templates", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": [{
+				"count": 10,
+				"days_of_week": ["MO", "FR"],
+				"duration": "5h0m0s",
+				"headcount": 2,
+				"id": 3,
+				"profession": "CNA",
+				"start_time": "05:00:00",
+				"starts_on": "2030-01-07"
+			}]
+		}`, rr.Body.String())
+	})
+}
+
+func TestCreateShiftTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should successfully create the shift template", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		count := 10
+		template := domain.ShiftTemplate{
+			Count:      &count,
+			DaysOfWeek: []time.Weekday{time.Monday, time.Friday},
+			Duration:   5 * time.Hour,
+			FacilityID: 7,
+			Headcount:  2,
+			Profession: domain.ProfessionCNA,
+			StartTime:  civil.Time{Hour: 5},
+			StartsOn:   &civil.Date{Year: 2030, Month: time.January, Day: 7},
+		}
+
+		created := template
+		created.ID = 3
+
+		mockCreateShiftTemplateUseCase := facility.NewMockCreateShiftTemplate(ctrl)
+		mockCreateShiftTemplateUseCase.
+			EXPECT().
+			CreateShiftTemplate(gomock.Any(), facility.CreateShiftTemplateRequest{Template: template}).
+			Times(1).
+			Return(&facility.CreateShiftTemplateResponse{Template: created}, nil)
+
+		handler := server.CreateShiftTemplate(mockCreateShiftTemplateUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/shift_templates", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/shift_templates", strings.NewReader(`{
+			"count": 10,
+			"days_of_week": ["MO", "FR"],
+			"duration": "5h",
+			"headcount": 2,
+			"profession": "CNA",
+			"start_time": "05:00",
+			"starts_on": "2030-01-07"
+		}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"id":3`)
+	})
+
+	t.Run("should return error when given an unknown day of the week", func(t *testing.T) {
+		t.Parallel()
+
+		handler := server.CreateShiftTemplate(nil)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/shift_templates", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/shift_templates", strings.NewReader(`{
+			"days_of_week": ["XX"],
+			"duration": "5h",
+			"headcount": 1,
+			"profession": "CNA",
+			"start_time": "05:00"
+		}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return bad request when the template is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCreateShiftTemplateUseCase := facility.NewMockCreateShiftTemplate(ctrl)
+		mockCreateShiftTemplateUseCase.
+			EXPECT().
+			CreateShiftTemplate(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrInvalidShiftTemplate)
+
+		handler := server.CreateShiftTemplate(mockCreateShiftTemplateUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/shift_templates", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/shift_templates", strings.NewReader(`{
+			"days_of_week": ["MO"],
+			"duration": "5h",
+			"headcount": 0,
+			"profession": "CNA",
+			"start_time": "05:00"
+		}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestMaterializeShiftTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should successfully materialize the template", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fakeStart := time.Date(2030, 1, 7, 5, 0, 0, 0, time.UTC)
+
+		mockMaterializeShiftTemplateUseCase := facility.NewMockMaterializeShiftTemplate(ctrl)
+		mockMaterializeShiftTemplateUseCase.
+			EXPECT().
+			MaterializeShiftTemplate(gomock.Any(), facility.MaterializeShiftTemplateRequest{
+				End:        civil.Date{Year: 2030, Month: time.January, Day: 7},
+				FacilityID: 7,
+				Start:      civil.Date{Year: 2030, Month: time.January, Day: 1},
+				TemplateID: 3,
+			}).
+			Times(1).
+			Return(&facility.MaterializeShiftTemplateResponse{
+				Materialization: domain.ShiftMaterialization{
+					Created: []domain.FacilityShift{
+						{
+							Profession: domain.ProfessionCNA,
+							Shift: domain.Shift{
+								End:      fakeStart.Add(5 * time.Hour),
+								Facility: domain.Facility{ID: 7, Name: "Facility", TimeZone: time.UTC},
+								ID:       10,
+								Start:    fakeStart,
+							},
+						},
+					},
+					Existing: 1,
+				},
+			}, nil)
+
+		handler := server.MaterializeShiftTemplate(mockMaterializeShiftTemplateUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/shift_templates/:template_id/materialize", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/shift_templates/3/materialize", strings.NewReader(`{"start": "2030-01-01", "end": "2030-01-07"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": [{
+				"end": "2030-01-07T10:00:00Z",
+				"facility": {"id": 7, "name": "Facility", "time_zone": "UTC"},
+				"id": 10,
+				"profession": "CNA",
+				"start": "2030-01-07T05:00:00Z",
+				"worker_id": null
+			}],
+			"existing": 1
+		}`, rr.Body.String())
+	})
+
+	t.Run("should return not found when the template does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockMaterializeShiftTemplateUseCase := facility.NewMockMaterializeShiftTemplate(ctrl)
+		mockMaterializeShiftTemplateUseCase.
+			EXPECT().
+			MaterializeShiftTemplate(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrShiftTemplateNotFound)
+
+		handler := server.MaterializeShiftTemplate(mockMaterializeShiftTemplateUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/shift_templates/:template_id/materialize", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/shift_templates/3/materialize", strings.NewReader(`{"start": "2030-01-01", "end": "2030-01-07"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestGetEligibleWorkersForShift(t *testing.T) {
+	t.Parallel()
+
+	fakeCodec, err := cursor.NewCodec([]byte("secret"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("should successfully return eligible workers with cursors scoped to the shift", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockEligibleWorkersUseCase := shift.NewMockEligibleWorkers(ctrl)
+		mockEligibleWorkersUseCase.
+			EXPECT().
+			GetEligibleWorkers(gomock.Any(), shift.GetEligibleWorkersRequest{
+				Limit:   1,
+				ShiftID: 10,
+			}).
+			Times(1).
+			Return(&shift.GetEligibleWorkersResponse{
+				NextCursor: cursor.New(cursor.DirectionAfter, "101"),
+				Workers: []domain.Worker{
+					{ID: 101, Name: "Ann", Profession: domain.ProfessionCNA},
+				},
+			}, nil)
+
+		handler := server.GetEligibleWorkersForShift(mockEligibleWorkersUseCase, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/shifts/:id/eligible_workers", server.HandlerFunc(handler))
+
+		get := func(url string) *httptest.ResponseRecorder {
+			req, err := http.NewRequest("GET", url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			rt.ServeHTTP(rr, req)
+
+			return rr
+		}
+
+		rr := get("/v1/shifts/10/eligible_workers?limit=1")
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp struct {
+			NextCursor *string `json:"next_cursor"`
+			PrevCursor *string `json:"prev_cursor"`
+			Workers    []struct {
+				ID         int64  `json:"id"`
+				Name       string `json:"name"`
+				Profession string `json:"profession"`
+			} `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Nil(t, resp.PrevCursor)
+		assert.Len(t, resp.Workers, 1)
+		assert.Equal(t, "CNA", resp.Workers[0].Profession)
+
+		if assert.NotNil(t, resp.NextCursor) {
+			rr = get("/v1/shifts/11/eligible_workers?cursor=" + *resp.NextCursor)
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		}
+	})
+
+	t.Run("should return not found when the shift does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockEligibleWorkersUseCase := shift.NewMockEligibleWorkers(ctrl)
+		mockEligibleWorkersUseCase.
+			EXPECT().
+			GetEligibleWorkers(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrShiftNotFound)
+
+		handler := server.GetEligibleWorkersForShift(mockEligibleWorkersUseCase, fakeCodec)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/shifts/:id/eligible_workers", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/shifts/10/eligible_workers", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestChangeFacilityStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should successfully suspend the facility, reporting affected workers", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fakeUntil := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+		fakeChangedAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		mockChangeStatusUseCase := facility.NewMockChangeStatus(ctrl)
+		mockChangeStatusUseCase.
+			EXPECT().
+			ChangeStatus(gomock.Any(), facility.ChangeStatusRequest{
+				Action:         domain.FacilityActionSuspend,
+				CancelClaims:   true,
+				FacilityID:     7,
+				SuspendedUntil: &fakeUntil,
+			}).
+			Times(1).
+			Return(&facility.ChangeStatusResponse{
+				Change: domain.FacilityLifecycleChange{
+					AffectedWorkers: []domain.AffectedWorker{{ShiftIDs: []int64{10, 11}, WorkerID: 101}},
+					Change: domain.FacilityStatusChange{
+						Action:          domain.FacilityActionSuspend,
+						CancelledClaims: true,
+						ChangedAt:       fakeChangedAt,
+						FacilityID:      7,
+						ID:              3,
+						SuspendedUntil:  &fakeUntil,
+					},
+					Status: domain.FacilityStatus{IsActive: true, SuspendedUntil: &fakeUntil},
+				},
+			}, nil)
+
+		handler := server.ChangeFacilityStatus(mockChangeStatusUseCase, domain.FacilityActionSuspend)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/suspend", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/suspend?cancel_claims=true", strings.NewReader(`{"until": "2030-01-02T00:00:00Z"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"affected_workers": [{"shift_ids": [10, 11], "worker_id": 101}],
+			"change": {
+				"action": "SUSPEND",
+				"cancelled_claims": true,
+				"changed_at": "2030-01-01T00:00:00Z",
+				"id": 3,
+				"suspended_until": "2030-01-02T00:00:00Z"
+			},
+			"data": {"is_active": true, "suspended_until": "2030-01-02T00:00:00Z"}
+		}`, rr.Body.String())
+	})
+
+	t.Run("should not read a body when activating", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockChangeStatusUseCase := facility.NewMockChangeStatus(ctrl)
+		mockChangeStatusUseCase.
+			EXPECT().
+			ChangeStatus(gomock.Any(), facility.ChangeStatusRequest{
+				Action:     domain.FacilityActionActivate,
+				FacilityID: 7,
+			}).
+			Times(1).
+			Return(&facility.ChangeStatusResponse{
+				Change: domain.FacilityLifecycleChange{
+					Change: domain.FacilityStatusChange{Action: domain.FacilityActionActivate},
+					Status: domain.FacilityStatus{IsActive: true},
+				},
+			}, nil)
+
+		handler := server.ChangeFacilityStatus(mockChangeStatusUseCase, domain.FacilityActionActivate)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/activate", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/activate", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should return bad request when the status change is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockChangeStatusUseCase := facility.NewMockChangeStatus(ctrl)
+		mockChangeStatusUseCase.
+			EXPECT().
+			ChangeStatus(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrInvalidFacilityStatus)
+
+		handler := server.ChangeFacilityStatus(mockChangeStatusUseCase, domain.FacilityActionSuspend)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPost, "/v1/facilities/:id/suspend", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("POST", "/v1/facilities/7/suspend", strings.NewReader(`{}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGetFacilityStatusChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return not found when the facility does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStatusChangesUseCase := facility.NewMockStatusChanges(ctrl)
+		mockStatusChangesUseCase.
+			EXPECT().
+			GetStatusChanges(gomock.Any(), facility.GetStatusChangesRequest{FacilityID: 7}).
+			Times(1).
+			Return(nil, domain.ErrFacilityNotFound)
+
+		handler := server.GetFacilityStatusChanges(mockStatusChangesUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/facilities/:id/status_changes", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/facilities/7/status_changes", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestGetWorker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should successfully return the worker", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockGetWorkerUseCase := worker.NewMockGetWorker(ctrl)
+		mockGetWorkerUseCase.
+			EXPECT().
+			GetWorker(gomock.Any(), worker.GetWorkerRequest{WorkerID: 101}).
+			Times(1).
+			Return(&worker.GetWorkerResponse{
+				Worker: domain.Worker{ID: 101, IsActive: true, Name: "Ann", Profession: domain.ProfessionCNA},
+			}, nil)
+
+		handler := server.GetWorker(mockGetWorkerUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/101", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": {"id": 101, "is_active": true, "name": "Ann", "profession": "CNA"}
+		}`, rr.Body.String())
+	})
+
+	t.Run("should return not found when the worker does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockGetWorkerUseCase := worker.NewMockGetWorker(ctrl)
+		mockGetWorkerUseCase.
+			EXPECT().
+			GetWorker(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrWorkerNotFound)
+
+		handler := server.GetWorker(mockGetWorkerUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodGet, "/v1/workers/:id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("GET", "/v1/workers/101", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestUpdateWorker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should successfully deactivate the worker, returning the released shifts", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		inactive := false
+		fakeStart := time.Date(2030, 1, 1, 6, 0, 0, 0, time.UTC)
+
+		mockUpdateWorkerUseCase := worker.NewMockUpdateWorker(ctrl)
+		mockUpdateWorkerUseCase.
+			EXPECT().
+			UpdateWorker(gomock.Any(), worker.UpdateWorkerRequest{
+				Update:   domain.WorkerUpdate{IsActive: &inactive},
+				WorkerID: 101,
+			}).
+			Times(1).
+			Return(&worker.UpdateWorkerResponse{
+				ReleasedShifts: []domain.Shift{
+					{
+						End:      fakeStart.Add(4 * time.Hour),
+						Facility: domain.Facility{ID: 7, Name: "Facility", TimeZone: time.UTC},
+						ID:       10,
+						Start:    fakeStart,
+					},
+				},
+				Worker: domain.Worker{ID: 101, Name: "Ann", Profession: domain.ProfessionCNA},
+			}, nil)
+
+		handler := server.UpdateWorker(mockUpdateWorkerUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPatch, "/v1/workers/:id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("PATCH", "/v1/workers/101", strings.NewReader(`{"is_active": false}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"data": {"id": 101, "is_active": false, "name": "Ann", "profession": "CNA"},
+			"released_shifts": [
+				{
+					"end": "2030-01-01T10:00:00Z",
+					"facility": {"id": 7, "name": "Facility", "time_zone": "UTC"},
+					"id": 10,
+					"start": "2030-01-01T06:00:00Z"
+				}
+			]
+		}`, rr.Body.String())
+	})
+
+	t.Run("should return bad request when the update is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUpdateWorkerUseCase := worker.NewMockUpdateWorker(ctrl)
+		mockUpdateWorkerUseCase.
+			EXPECT().
+			UpdateWorker(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrInvalidWorker)
+
+		handler := server.UpdateWorker(mockUpdateWorkerUseCase)
+
+		rt := httprouter.New()
+		rt.Handler(http.MethodPatch, "/v1/workers/:id", server.HandlerFunc(handler))
+
+		req, err := http.NewRequest("PATCH", "/v1/workers/101", strings.NewReader(`{}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		rt.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
 func TestHealthcheck(t *testing.T) {
 	t.Parallel()
 
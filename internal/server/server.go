@@ -9,8 +9,12 @@ import (
 
 	"github.com/rodrigosdo/facilities-api/internal/config"
 	"github.com/rodrigosdo/facilities-api/internal/cursor"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
 	"github.com/rodrigosdo/facilities-api/internal/postgres"
 	"github.com/rodrigosdo/facilities-api/internal/server/middleware"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/document"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/shift"
 	"github.com/rodrigosdo/facilities-api/internal/usecase/worker"
 
 	"cloud.google.com/go/civil"
@@ -36,13 +40,229 @@ type GetAvailableShiftsFromUserRequest struct {
 }
 
 type GetAvailableShiftsFromUserReponse struct {
-	NextCursor *cursor.Cursor   `json:"next_cursor"`
+	NextCursor *string          `json:"next_cursor"`
+	PrevCursor *string          `json:"prev_cursor"`
 	Shifts     []AvailableShift `json:"data"`
 }
 
+type GetAvailableShiftsByDateFromUserReponse struct {
+	NextCursor *string                 `json:"next_cursor"`
+	PrevCursor *string                 `json:"prev_cursor"`
+	Dates      []AvailableShiftsOfDate `json:"data"`
+}
+
+type AvailableShiftsOfDate struct {
+	Date    civil.Date       `json:"date"`
+	Partial bool             `json:"partial"`
+	Shifts  []AvailableShift `json:"shifts"`
+}
+
+type CancelShiftFromWorkerResponse struct {
+	Shift AvailableShift `json:"data"`
+}
+
+type ClaimShiftFromWorkerResponse struct {
+	Shift AvailableShift `json:"data"`
+}
+
+type GetShiftEligibilityFromWorkerResponse struct {
+	Eligibility ShiftEligibility `json:"data"`
+}
+
+type ShiftEligibility struct {
+	Checks             []EligibilityCheck `json:"checks"`
+	Eligible           bool               `json:"eligible"`
+	MissingDocumentIDs []int64            `json:"missing_document_ids"`
+	ShiftID            int64              `json:"shift_id"`
+	WorkerID           int64              `json:"worker_id"`
+}
+
+type EligibilityCheck struct {
+	Passed bool   `json:"passed"`
+	Rule   string `json:"rule"`
+}
+
+type GetWorkerDocumentsResponse struct {
+	WorkerDocuments []WorkerDocument `json:"data"`
+}
+
+type AddWorkerDocumentRequest struct {
+	DocumentID int64      `json:"document_id"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+}
+
+type AddWorkerDocumentResponse struct {
+	NewlyEligibleFacilities []Facility     `json:"newly_eligible_facilities"`
+	WorkerDocument          WorkerDocument `json:"data"`
+}
+
+type WorkerDocument struct {
+	Document  Document   `json:"document"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	ID        int64      `json:"id"`
+	Status    string     `json:"status"`
+}
+
+type Document struct {
+	ID       int64  `json:"id"`
+	IsActive bool   `json:"is_active"`
+	Name     string `json:"name"`
+}
+
+type GetFacilityRequirementsResponse struct {
+	Requirements []FacilityRequirement `json:"data"`
+}
+
+type FacilityRequirementRequest struct {
+	DocumentID int64              `json:"document_id"`
+	Profession *domain.Profession `json:"profession"`
+}
+
+type FacilityRequirementChangeResponse struct {
+	DryRun      bool                `json:"dry_run"`
+	Impact      []EligibilityImpact `json:"impact"`
+	Requirement FacilityRequirement `json:"data"`
+}
+
+type FacilityRequirement struct {
+	Document   Document `json:"document"`
+	ID         int64    `json:"id"`
+	Profession *string  `json:"profession"`
+}
+
+type EligibilityImpact struct {
+	Gained     int    `json:"gained"`
+	Lost       int    `json:"lost"`
+	Profession string `json:"profession"`
+}
+
+type CreateFacilityShiftRequest struct {
+	End        time.Time         `json:"end"`
+	Profession domain.Profession `json:"profession"`
+	Start      time.Time         `json:"start"`
+}
+
+type UpdateFacilityShiftRequest struct {
+	End        *time.Time         `json:"end"`
+	Profession *domain.Profession `json:"profession"`
+	Start      *time.Time         `json:"start"`
+}
+
+type FacilityShiftResponse struct {
+	Shift FacilityShift `json:"data"`
+}
+
+type FacilityShift struct {
+	End        time.Time `json:"end"`
+	Facility   Facility  `json:"facility"`
+	ID         int64     `json:"id"`
+	Profession string    `json:"profession"`
+	Start      time.Time `json:"start"`
+	WorkerID   *int64    `json:"worker_id"`
+}
+
+type GetShiftTemplatesResponse struct {
+	Templates []ShiftTemplate `json:"data"`
+}
+
+type CreateShiftTemplateRequest struct {
+	Count      *int              `json:"count"`
+	DaysOfWeek []string          `json:"days_of_week"`
+	Duration   string            `json:"duration"`
+	Headcount  int               `json:"headcount"`
+	Profession domain.Profession `json:"profession"`
+	StartTime  string            `json:"start_time"`
+	StartsOn   *civil.Date       `json:"starts_on"`
+}
+
+type ShiftTemplateResponse struct {
+	Template ShiftTemplate `json:"data"`
+}
+
+type ShiftTemplate struct {
+	Count      *int        `json:"count"`
+	DaysOfWeek []string    `json:"days_of_week"`
+	Duration   string      `json:"duration"`
+	Headcount  int         `json:"headcount"`
+	ID         int64       `json:"id"`
+	Profession string      `json:"profession"`
+	StartTime  string      `json:"start_time"`
+	StartsOn   *civil.Date `json:"starts_on"`
+}
+
+type MaterializeShiftTemplateRequest struct {
+	End   civil.Date `json:"end"`
+	Start civil.Date `json:"start"`
+}
+
+type MaterializeShiftTemplateResponse struct {
+	Existing int             `json:"existing"`
+	Shifts   []FacilityShift `json:"data"`
+}
+
+type GetFacilityStatusChangesResponse struct {
+	Changes []FacilityStatusChange `json:"data"`
+}
+
+type SuspendFacilityRequest struct {
+	Until *time.Time `json:"until"`
+}
+
+type FacilityLifecycleChangeResponse struct {
+	AffectedWorkers []AffectedWorker     `json:"affected_workers"`
+	Change          FacilityStatusChange `json:"change"`
+	Status          FacilityStatus       `json:"data"`
+}
+
+type FacilityStatus struct {
+	IsActive       bool       `json:"is_active"`
+	SuspendedUntil *time.Time `json:"suspended_until"`
+}
+
+type FacilityStatusChange struct {
+	Action          string     `json:"action"`
+	CancelledClaims bool       `json:"cancelled_claims"`
+	ChangedAt       time.Time  `json:"changed_at"`
+	ID              int64      `json:"id"`
+	SuspendedUntil  *time.Time `json:"suspended_until"`
+}
+
+type AffectedWorker struct {
+	ShiftIDs []int64 `json:"shift_ids"`
+	WorkerID int64   `json:"worker_id"`
+}
+
+type GetEligibleWorkersForShiftResponse struct {
+	NextCursor *string  `json:"next_cursor"`
+	PrevCursor *string  `json:"prev_cursor"`
+	Workers    []Worker `json:"data"`
+}
+
+type UpdateWorkerRequest struct {
+	IsActive   *bool              `json:"is_active"`
+	Profession *domain.Profession `json:"profession"`
+}
+
+type WorkerResponse struct {
+	Worker Worker `json:"data"`
+}
+
+type UpdateWorkerResponse struct {
+	ReleasedShifts []AvailableShift `json:"released_shifts"`
+	Worker         Worker           `json:"data"`
+}
+
+type Worker struct {
+	ID         int64  `json:"id"`
+	IsActive   bool   `json:"is_active"`
+	Name       string `json:"name"`
+	Profession string `json:"profession"`
+}
+
 type Facility struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	TimeZone string `json:"time_zone"`
 }
 
 type AvailableShift struct {
@@ -52,16 +272,70 @@ type AvailableShift struct {
 	Start    time.Time `json:"start"`
 }
 
+// UseCases holds the use cases the handlers of the server call.
+type UseCases struct {
+	AddFacilityRequirement    facility.AddRequirement
+	AddWorkerDocument         document.AddWorkerDocument
+	ChangeFacilityStatus      facility.ChangeStatus
+	CreateFacilityShift       facility.CreateShift
+	CreateShiftTemplate       facility.CreateShiftTemplate
+	DeleteFacilityShift       facility.DeleteShift
+	EligibleWorkers           shift.EligibleWorkers
+	FacilityRequirements      facility.Requirements
+	FacilityStatusChanges     facility.StatusChanges
+	GetWorker                 worker.GetWorker
+	MaterializeShiftTemplate  facility.MaterializeShiftTemplate
+	RemoveFacilityRequirement facility.RemoveRequirement
+	RemoveWorkerDocument      document.RemoveWorkerDocument
+	ShiftTemplates            facility.ShiftTemplates
+	UpdateFacilityRequirement facility.UpdateRequirement
+	UpdateFacilityShift       facility.UpdateShift
+	UpdateWorker              worker.UpdateWorker
+	WorkerAvailableShifts     worker.AvailableShifts
+	WorkerCancelShift         worker.CancelShift
+	WorkerClaimShift          worker.ClaimShift
+	WorkerDocuments           document.WorkerDocuments
+	WorkerShiftEligibility    worker.ShiftEligibility
+}
+
 func New(
 	addr string,
 	cfg *config.Config,
 	logger *zap.Logger,
 	conn postgres.Conn,
-	workerAvailableShiftsUseCase worker.AvailableShifts,
+	useCases UseCases,
 ) (*Server, error) {
+	cursorCodec, err := cursor.NewCodec([]byte(cfg.Cursor.Secret), cfg.Cursor.TTL)
+	if err != nil {
+		return nil, err
+	}
+
 	rt := httprouter.New()
 	rt.Handler(http.MethodGet, "/healthcheck", NewHandler(Healthcheck(conn), logger))
-	rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", NewHandler(GetAvailableShiftsFromWorker(workerAvailableShiftsUseCase), logger))
+	rt.Handler(http.MethodGet, "/v1/workers/:id/available_shifts", NewHandler(GetAvailableShiftsFromWorker(useCases.WorkerAvailableShifts, cursorCodec), logger))
+	rt.Handler(http.MethodPost, "/v1/workers/:id/shifts/:shift_id/claim", NewHandler(ClaimShiftFromWorker(useCases.WorkerClaimShift), logger))
+	rt.Handler(http.MethodPost, "/v1/workers/:id/shifts/:shift_id/cancel", NewHandler(CancelShiftFromWorker(useCases.WorkerCancelShift), logger))
+	rt.Handler(http.MethodGet, "/v1/workers/:id/shifts/:shift_id/eligibility", NewHandler(GetShiftEligibilityFromWorker(useCases.WorkerShiftEligibility), logger))
+	rt.Handler(http.MethodGet, "/v1/workers/:id", NewHandler(GetWorker(useCases.GetWorker), logger))
+	rt.Handler(http.MethodPatch, "/v1/workers/:id", NewHandler(UpdateWorker(useCases.UpdateWorker), logger))
+	rt.Handler(http.MethodGet, "/v1/workers/:id/documents", NewHandler(GetWorkerDocuments(useCases.WorkerDocuments), logger))
+	rt.Handler(http.MethodPost, "/v1/workers/:id/documents", NewHandler(AddWorkerDocument(useCases.AddWorkerDocument), logger))
+	rt.Handler(http.MethodDelete, "/v1/workers/:id/documents/:document_id", NewHandler(RemoveWorkerDocument(useCases.RemoveWorkerDocument), logger))
+	rt.Handler(http.MethodGet, "/v1/facilities/:id/requirements", NewHandler(GetFacilityRequirements(useCases.FacilityRequirements), logger))
+	rt.Handler(http.MethodPost, "/v1/facilities/:id/requirements", NewHandler(AddFacilityRequirement(useCases.AddFacilityRequirement), logger))
+	rt.Handler(http.MethodPut, "/v1/facilities/:id/requirements/:requirement_id", NewHandler(UpdateFacilityRequirement(useCases.UpdateFacilityRequirement), logger))
+	rt.Handler(http.MethodDelete, "/v1/facilities/:id/requirements/:requirement_id", NewHandler(RemoveFacilityRequirement(useCases.RemoveFacilityRequirement), logger))
+	rt.Handler(http.MethodPost, "/v1/facilities/:id/shifts", NewHandler(CreateFacilityShift(useCases.CreateFacilityShift), logger))
+	rt.Handler(http.MethodPatch, "/v1/facilities/:id/shifts/:shift_id", NewHandler(UpdateFacilityShift(useCases.UpdateFacilityShift), logger))
+	rt.Handler(http.MethodDelete, "/v1/facilities/:id/shifts/:shift_id", NewHandler(DeleteFacilityShift(useCases.DeleteFacilityShift), logger))
+	rt.Handler(http.MethodGet, "/v1/facilities/:id/shift_templates", NewHandler(GetShiftTemplates(useCases.ShiftTemplates), logger))
+	rt.Handler(http.MethodPost, "/v1/facilities/:id/shift_templates", NewHandler(CreateShiftTemplate(useCases.CreateShiftTemplate), logger))
+	rt.Handler(http.MethodPost, "/v1/facilities/:id/shift_templates/:template_id/materialize", NewHandler(MaterializeShiftTemplate(useCases.MaterializeShiftTemplate), logger))
+	rt.Handler(http.MethodGet, "/v1/facilities/:id/status_changes", NewHandler(GetFacilityStatusChanges(useCases.FacilityStatusChanges), logger))
+	rt.Handler(http.MethodPost, "/v1/facilities/:id/activate", NewHandler(ChangeFacilityStatus(useCases.ChangeFacilityStatus, domain.FacilityActionActivate), logger))
+	rt.Handler(http.MethodPost, "/v1/facilities/:id/deactivate", NewHandler(ChangeFacilityStatus(useCases.ChangeFacilityStatus, domain.FacilityActionDeactivate), logger))
+	rt.Handler(http.MethodPost, "/v1/facilities/:id/suspend", NewHandler(ChangeFacilityStatus(useCases.ChangeFacilityStatus, domain.FacilityActionSuspend), logger))
+	rt.Handler(http.MethodGet, "/v1/shifts/:id/eligible_workers", NewHandler(GetEligibleWorkersForShift(useCases.EligibleWorkers, cursorCodec), logger))
 
 	handler := alice.New(
 		middleware.PanicRecovery(logger),
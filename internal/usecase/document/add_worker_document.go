@@ -0,0 +1,69 @@
+package document
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/document/add_worker_document_mock.go -package=document -source=internal/usecase/document/add_worker_document.go AddWorkerDocument
+type AddWorkerDocument interface {
+	AddWorkerDocument(ctx context.Context, req AddWorkerDocumentRequest) (*AddWorkerDocumentResponse, error)
+}
+
+type addWorkerDocument struct {
+	documentRepository domain.DocumentRepository
+}
+
+func NewAddWorkerDocument(dr domain.DocumentRepository) AddWorkerDocument {
+	return &addWorkerDocument{
+		documentRepository: dr,
+	}
+}
+
+type AddWorkerDocumentRequest struct {
+	DocumentID int64
+	ExpiresAt  *time.Time
+	WorkerID   int64
+}
+
+// AddWorkerDocumentResponse holds the added document, pending verification,
+// along with the facilities the worker becomes eligible for, document wise,
+// once it is verified.
+type AddWorkerDocumentResponse struct {
+	NewlyEligibleFacilities []domain.Facility
+	WorkerDocument          domain.WorkerDocument
+}
+
+func (awd *addWorkerDocument) AddWorkerDocument(ctx context.Context, req AddWorkerDocumentRequest) (*AddWorkerDocumentResponse, error) {
+	if req.WorkerID == 0 {
+		return nil, errors.New("a worker_id is required to add a document to a worker")
+	}
+
+	if req.DocumentID == 0 {
+		return nil, errors.New("a document_id is required to add a document to a worker")
+	}
+
+	now := time.Now()
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return nil, domain.ErrDocumentExpired
+	}
+
+	workerDocument, err := awd.documentRepository.AddWorkerDocument(ctx, req.WorkerID, req.DocumentID, req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	facilities, err := awd.documentRepository.GetNewlyEligibleFacilities(ctx, req.WorkerID, req.DocumentID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddWorkerDocumentResponse{
+		NewlyEligibleFacilities: facilities,
+		WorkerDocument:          *workerDocument,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/document/add_worker_document.go
+
+// Package document is a generated GoMock package.
+package document
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAddWorkerDocument is a mock of AddWorkerDocument interface.
+type MockAddWorkerDocument struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddWorkerDocumentMockRecorder
+}
+
+// MockAddWorkerDocumentMockRecorder is the mock recorder for MockAddWorkerDocument.
+type MockAddWorkerDocumentMockRecorder struct {
+	mock *MockAddWorkerDocument
+}
+
+// NewMockAddWorkerDocument creates a new mock instance.
+func NewMockAddWorkerDocument(ctrl *gomock.Controller) *MockAddWorkerDocument {
+	mock := &MockAddWorkerDocument{ctrl: ctrl}
+	mock.recorder = &MockAddWorkerDocumentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddWorkerDocument) EXPECT() *MockAddWorkerDocumentMockRecorder {
+	return m.recorder
+}
+
+// AddWorkerDocument mocks base method.
+func (m *MockAddWorkerDocument) AddWorkerDocument(ctx context.Context, req AddWorkerDocumentRequest) (*AddWorkerDocumentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkerDocument", ctx, req)
+	ret0, _ := ret[0].(*AddWorkerDocumentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkerDocument indicates an expected call of AddWorkerDocument.
+func (mr *MockAddWorkerDocumentMockRecorder) AddWorkerDocument(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkerDocument", reflect.TypeOf((*MockAddWorkerDocument)(nil).AddWorkerDocument), ctx, req)
+}
@@ -0,0 +1,123 @@
+package document_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/document"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddWorkerDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeDocumentID := int64(4)
+	fakeWorkerID := int64(123123)
+	fakeExpiresAt := time.Now().Add(24 * time.Hour)
+	fakeWorkerDocument := domain.WorkerDocument{
+		Document:  domain.Document{ID: fakeDocumentID, IsActive: true, Name: "License"},
+		ExpiresAt: &fakeExpiresAt,
+		ID:        10,
+		Status:    domain.DocumentStatusPending,
+		WorkerID:  fakeWorkerID,
+	}
+	fakeFacilities := []domain.Facility{
+		{ID: 7, Name: "Facility", TimeZone: time.UTC},
+	}
+
+	t.Run("should return error when no worker is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := document.NewAddWorkerDocument(nil)
+		addedDocument, err := us.AddWorkerDocument(ctx, document.AddWorkerDocumentRequest{
+			DocumentID: fakeDocumentID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, addedDocument)
+	})
+
+	t.Run("should return error when no document is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := document.NewAddWorkerDocument(nil)
+		addedDocument, err := us.AddWorkerDocument(ctx, document.AddWorkerDocumentRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, addedDocument)
+	})
+
+	t.Run("should return error when the document is already expired", func(t *testing.T) {
+		t.Parallel()
+
+		expiresAt := time.Now().Add(-time.Hour)
+
+		us := document.NewAddWorkerDocument(nil)
+		addedDocument, err := us.AddWorkerDocument(ctx, document.AddWorkerDocumentRequest{
+			DocumentID: fakeDocumentID,
+			ExpiresAt:  &expiresAt,
+			WorkerID:   fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrDocumentExpired)
+		assert.Nil(t, addedDocument)
+	})
+
+	t.Run("should return error if the document is already held", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockDocumentRepository := domain.NewMockDocumentRepository(ctrl)
+		mockDocumentRepository.
+			EXPECT().
+			AddWorkerDocument(ctx, fakeWorkerID, fakeDocumentID, &fakeExpiresAt).
+			Times(1).
+			Return(nil, domain.ErrDocumentAlreadyHeld)
+
+		us := document.NewAddWorkerDocument(mockDocumentRepository)
+		addedDocument, err := us.AddWorkerDocument(ctx, document.AddWorkerDocumentRequest{
+			DocumentID: fakeDocumentID,
+			ExpiresAt:  &fakeExpiresAt,
+			WorkerID:   fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrDocumentAlreadyHeld)
+		assert.Nil(t, addedDocument)
+	})
+
+	t.Run("should successfully add the document and return the newly eligible facilities", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockDocumentRepository := domain.NewMockDocumentRepository(ctrl)
+		mockDocumentRepository.
+			EXPECT().
+			AddWorkerDocument(ctx, fakeWorkerID, fakeDocumentID, &fakeExpiresAt).
+			Times(1).
+			Return(&fakeWorkerDocument, nil)
+		mockDocumentRepository.
+			EXPECT().
+			GetNewlyEligibleFacilities(ctx, fakeWorkerID, fakeDocumentID, gomock.Any()).
+			Times(1).
+			Return(fakeFacilities, nil)
+
+		us := document.NewAddWorkerDocument(mockDocumentRepository)
+		addedDocument, err := us.AddWorkerDocument(ctx, document.AddWorkerDocumentRequest{
+			DocumentID: fakeDocumentID,
+			ExpiresAt:  &fakeExpiresAt,
+			WorkerID:   fakeWorkerID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &document.AddWorkerDocumentResponse{
+			NewlyEligibleFacilities: fakeFacilities,
+			WorkerDocument:          fakeWorkerDocument,
+		}, addedDocument)
+	})
+}
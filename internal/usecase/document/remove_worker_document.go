@@ -0,0 +1,40 @@
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/document/remove_worker_document_mock.go -package=document -source=internal/usecase/document/remove_worker_document.go RemoveWorkerDocument
+type RemoveWorkerDocument interface {
+	RemoveWorkerDocument(ctx context.Context, req RemoveWorkerDocumentRequest) error
+}
+
+type removeWorkerDocument struct {
+	documentRepository domain.DocumentRepository
+}
+
+func NewRemoveWorkerDocument(dr domain.DocumentRepository) RemoveWorkerDocument {
+	return &removeWorkerDocument{
+		documentRepository: dr,
+	}
+}
+
+type RemoveWorkerDocumentRequest struct {
+	DocumentID int64
+	WorkerID   int64
+}
+
+func (rwd *removeWorkerDocument) RemoveWorkerDocument(ctx context.Context, req RemoveWorkerDocumentRequest) error {
+	if req.WorkerID == 0 {
+		return errors.New("a worker_id is required to remove a document from a worker")
+	}
+
+	if req.DocumentID == 0 {
+		return errors.New("a document_id is required to remove a document from a worker")
+	}
+
+	return rwd.documentRepository.RemoveWorkerDocument(ctx, req.WorkerID, req.DocumentID)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/document/remove_worker_document.go
+
+// Package document is a generated GoMock package.
+package document
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRemoveWorkerDocument is a mock of RemoveWorkerDocument interface.
+type MockRemoveWorkerDocument struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveWorkerDocumentMockRecorder
+}
+
+// MockRemoveWorkerDocumentMockRecorder is the mock recorder for MockRemoveWorkerDocument.
+type MockRemoveWorkerDocumentMockRecorder struct {
+	mock *MockRemoveWorkerDocument
+}
+
+// NewMockRemoveWorkerDocument creates a new mock instance.
+func NewMockRemoveWorkerDocument(ctrl *gomock.Controller) *MockRemoveWorkerDocument {
+	mock := &MockRemoveWorkerDocument{ctrl: ctrl}
+	mock.recorder = &MockRemoveWorkerDocumentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveWorkerDocument) EXPECT() *MockRemoveWorkerDocumentMockRecorder {
+	return m.recorder
+}
+
+// RemoveWorkerDocument mocks base method.
+func (m *MockRemoveWorkerDocument) RemoveWorkerDocument(ctx context.Context, req RemoveWorkerDocumentRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWorkerDocument", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWorkerDocument indicates an expected call of RemoveWorkerDocument.
+func (mr *MockRemoveWorkerDocumentMockRecorder) RemoveWorkerDocument(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkerDocument", reflect.TypeOf((*MockRemoveWorkerDocument)(nil).RemoveWorkerDocument), ctx, req)
+}
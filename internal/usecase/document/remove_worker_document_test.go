@@ -0,0 +1,82 @@
+package document_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/document"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveWorkerDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeDocumentID := int64(4)
+	fakeWorkerID := int64(123123)
+
+	t.Run("should return error when no worker is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := document.NewRemoveWorkerDocument(nil)
+		err := us.RemoveWorkerDocument(ctx, document.RemoveWorkerDocumentRequest{
+			DocumentID: fakeDocumentID,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when no document is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := document.NewRemoveWorkerDocument(nil)
+		err := us.RemoveWorkerDocument(ctx, document.RemoveWorkerDocumentRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error if the worker does not hold the document", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockDocumentRepository := domain.NewMockDocumentRepository(ctrl)
+		mockDocumentRepository.
+			EXPECT().
+			RemoveWorkerDocument(ctx, fakeWorkerID, fakeDocumentID).
+			Times(1).
+			Return(domain.ErrWorkerDocumentNotFound)
+
+		us := document.NewRemoveWorkerDocument(mockDocumentRepository)
+		err := us.RemoveWorkerDocument(ctx, document.RemoveWorkerDocumentRequest{
+			DocumentID: fakeDocumentID,
+			WorkerID:   fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrWorkerDocumentNotFound)
+	})
+
+	t.Run("should successfully remove the document", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockDocumentRepository := domain.NewMockDocumentRepository(ctrl)
+		mockDocumentRepository.
+			EXPECT().
+			RemoveWorkerDocument(ctx, fakeWorkerID, fakeDocumentID).
+			Times(1).
+			Return(nil)
+
+		us := document.NewRemoveWorkerDocument(mockDocumentRepository)
+		err := us.RemoveWorkerDocument(ctx, document.RemoveWorkerDocumentRequest{
+			DocumentID: fakeDocumentID,
+			WorkerID:   fakeWorkerID,
+		})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,46 @@
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/document/worker_documents_mock.go -package=document -source=internal/usecase/document/worker_documents.go WorkerDocuments
+type WorkerDocuments interface {
+	GetWorkerDocuments(ctx context.Context, req GetWorkerDocumentsRequest) (*GetWorkerDocumentsResponse, error)
+}
+
+type workerDocuments struct {
+	documentRepository domain.DocumentRepository
+}
+
+func NewWorkerDocuments(dr domain.DocumentRepository) WorkerDocuments {
+	return &workerDocuments{
+		documentRepository: dr,
+	}
+}
+
+type GetWorkerDocumentsRequest struct {
+	WorkerID int64
+}
+
+type GetWorkerDocumentsResponse struct {
+	WorkerDocuments []domain.WorkerDocument
+}
+
+func (wd *workerDocuments) GetWorkerDocuments(ctx context.Context, req GetWorkerDocumentsRequest) (*GetWorkerDocumentsResponse, error) {
+	if req.WorkerID == 0 {
+		return nil, errors.New("a worker_id is required to get the documents of a worker")
+	}
+
+	workerDocuments, err := wd.documentRepository.GetWorkerDocuments(ctx, req.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetWorkerDocumentsResponse{
+		WorkerDocuments: workerDocuments,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/document/worker_documents.go
+
+// Package document is a generated GoMock package.
+package document
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWorkerDocuments is a mock of WorkerDocuments interface.
+type MockWorkerDocuments struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkerDocumentsMockRecorder
+}
+
+// MockWorkerDocumentsMockRecorder is the mock recorder for MockWorkerDocuments.
+type MockWorkerDocumentsMockRecorder struct {
+	mock *MockWorkerDocuments
+}
+
+// NewMockWorkerDocuments creates a new mock instance.
+func NewMockWorkerDocuments(ctrl *gomock.Controller) *MockWorkerDocuments {
+	mock := &MockWorkerDocuments{ctrl: ctrl}
+	mock.recorder = &MockWorkerDocumentsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkerDocuments) EXPECT() *MockWorkerDocumentsMockRecorder {
+	return m.recorder
+}
+
+// GetWorkerDocuments mocks base method.
+func (m *MockWorkerDocuments) GetWorkerDocuments(ctx context.Context, req GetWorkerDocumentsRequest) (*GetWorkerDocumentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerDocuments", ctx, req)
+	ret0, _ := ret[0].(*GetWorkerDocumentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerDocuments indicates an expected call of GetWorkerDocuments.
+func (mr *MockWorkerDocumentsMockRecorder) GetWorkerDocuments(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerDocuments", reflect.TypeOf((*MockWorkerDocuments)(nil).GetWorkerDocuments), ctx, req)
+}
@@ -0,0 +1,78 @@
+package document_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/document"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWorkerDocuments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeWorkerID := int64(123123)
+	fakeWorkerDocuments := []domain.WorkerDocument{
+		{
+			Document: domain.Document{ID: 4, IsActive: true, Name: "License"},
+			ID:       10,
+			Status:   domain.DocumentStatusVerified,
+			WorkerID: fakeWorkerID,
+		},
+	}
+
+	t.Run("should return error when no worker is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := document.NewWorkerDocuments(nil)
+		workerDocuments, err := us.GetWorkerDocuments(ctx, document.GetWorkerDocumentsRequest{})
+		assert.Error(t, err)
+		assert.Nil(t, workerDocuments)
+	})
+
+	t.Run("should return error if the worker does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockDocumentRepository := domain.NewMockDocumentRepository(ctrl)
+		mockDocumentRepository.
+			EXPECT().
+			GetWorkerDocuments(ctx, fakeWorkerID).
+			Times(1).
+			Return(nil, domain.ErrWorkerNotFound)
+
+		us := document.NewWorkerDocuments(mockDocumentRepository)
+		workerDocuments, err := us.GetWorkerDocuments(ctx, document.GetWorkerDocumentsRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrWorkerNotFound)
+		assert.Nil(t, workerDocuments)
+	})
+
+	t.Run("should successfully return the documents of the worker", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockDocumentRepository := domain.NewMockDocumentRepository(ctrl)
+		mockDocumentRepository.
+			EXPECT().
+			GetWorkerDocuments(ctx, fakeWorkerID).
+			Times(1).
+			Return(fakeWorkerDocuments, nil)
+
+		us := document.NewWorkerDocuments(mockDocumentRepository)
+		workerDocuments, err := us.GetWorkerDocuments(ctx, document.GetWorkerDocumentsRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &document.GetWorkerDocumentsResponse{WorkerDocuments: fakeWorkerDocuments}, workerDocuments)
+	})
+}
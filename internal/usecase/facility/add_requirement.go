@@ -0,0 +1,62 @@
+package facility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/add_requirement_mock.go -package=facility -source=internal/usecase/facility/add_requirement.go AddRequirement
+type AddRequirement interface {
+	AddRequirement(ctx context.Context, req AddRequirementRequest) (*AddRequirementResponse, error)
+}
+
+type addRequirement struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewAddRequirement(fr domain.FacilityRepository) AddRequirement {
+	return &addRequirement{
+		facilityRepository: fr,
+	}
+}
+
+// AddRequirementRequest asks for a document to be required by a facility for
+// its shifts of a profession, or of every profession when it has none. On dry
+// runs, the impact of the requirement is reported without adding it.
+type AddRequirementRequest struct {
+	DocumentID int64
+	DryRun     bool
+	FacilityID int64
+	Profession *domain.Profession
+}
+
+type AddRequirementResponse struct {
+	Change domain.RequirementChange
+}
+
+func (ar *addRequirement) AddRequirement(ctx context.Context, req AddRequirementRequest) (*AddRequirementResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to add a requirement")
+	}
+
+	if req.DocumentID == 0 {
+		return nil, errors.New("a document_id is required to add a requirement")
+	}
+
+	if req.Profession != nil && !req.Profession.Valid() {
+		return nil, fmt.Errorf("unknown profession %q", *req.Profession)
+	}
+
+	change, err := ar.facilityRepository.AddFacilityRequirement(ctx, req.FacilityID, req.DocumentID, req.Profession, time.Now(), req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddRequirementResponse{
+		Change: *change,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/add_requirement.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAddRequirement is a mock of AddRequirement interface.
+type MockAddRequirement struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddRequirementMockRecorder
+}
+
+// MockAddRequirementMockRecorder is the mock recorder for MockAddRequirement.
+type MockAddRequirementMockRecorder struct {
+	mock *MockAddRequirement
+}
+
+// NewMockAddRequirement creates a new mock instance.
+func NewMockAddRequirement(ctrl *gomock.Controller) *MockAddRequirement {
+	mock := &MockAddRequirement{ctrl: ctrl}
+	mock.recorder = &MockAddRequirementMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddRequirement) EXPECT() *MockAddRequirementMockRecorder {
+	return m.recorder
+}
+
+// AddRequirement mocks base method.
+func (m *MockAddRequirement) AddRequirement(ctx context.Context, req AddRequirementRequest) (*AddRequirementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRequirement", ctx, req)
+	ret0, _ := ret[0].(*AddRequirementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRequirement indicates an expected call of AddRequirement.
+func (mr *MockAddRequirementMockRecorder) AddRequirement(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRequirement", reflect.TypeOf((*MockAddRequirement)(nil).AddRequirement), ctx, req)
+}
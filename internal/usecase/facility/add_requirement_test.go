@@ -0,0 +1,97 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddRequirement(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeDocumentID := int64(4)
+	fakeFacilityID := int64(7)
+	fakeChange := domain.RequirementChange{
+		Impact: []domain.EligibilityImpact{
+			{Lost: 3, Profession: domain.ProfessionCNA},
+		},
+		Requirement: domain.FacilityRequirement{
+			Document:   domain.Document{ID: fakeDocumentID, IsActive: true, Name: "License"},
+			FacilityID: fakeFacilityID,
+			ID:         10,
+		},
+	}
+
+	t.Run("should return error when no facility is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewAddRequirement(nil)
+		added, err := us.AddRequirement(ctx, facility.AddRequirementRequest{
+			DocumentID: fakeDocumentID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, added)
+	})
+
+	t.Run("should return error when no document is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewAddRequirement(nil)
+		added, err := us.AddRequirement(ctx, facility.AddRequirementRequest{
+			FacilityID: fakeFacilityID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, added)
+	})
+
+	t.Run("should return error if the document is already required", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			AddFacilityRequirement(ctx, fakeFacilityID, fakeDocumentID, nil, gomock.Any(), false).
+			Times(1).
+			Return(nil, domain.ErrRequirementAlreadyExists)
+
+		us := facility.NewAddRequirement(mockFacilityRepository)
+		added, err := us.AddRequirement(ctx, facility.AddRequirementRequest{
+			DocumentID: fakeDocumentID,
+			FacilityID: fakeFacilityID,
+		})
+		assert.ErrorIs(t, err, domain.ErrRequirementAlreadyExists)
+		assert.Nil(t, added)
+	})
+
+	t.Run("should successfully report the impact of a dry run", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			AddFacilityRequirement(ctx, fakeFacilityID, fakeDocumentID, nil, gomock.Any(), true).
+			Times(1).
+			Return(&fakeChange, nil)
+
+		us := facility.NewAddRequirement(mockFacilityRepository)
+		added, err := us.AddRequirement(ctx, facility.AddRequirementRequest{
+			DocumentID: fakeDocumentID,
+			DryRun:     true,
+			FacilityID: fakeFacilityID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.AddRequirementResponse{Change: fakeChange}, added)
+	})
+}
@@ -0,0 +1,78 @@
+package facility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/change_status_mock.go -package=facility -source=internal/usecase/facility/change_status.go ChangeStatus
+type ChangeStatus interface {
+	ChangeStatus(ctx context.Context, req ChangeStatusRequest) (*ChangeStatusResponse, error)
+}
+
+type changeStatus struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewChangeStatus(fr domain.FacilityRepository) ChangeStatus {
+	return &changeStatus{
+		facilityRepository: fr,
+	}
+}
+
+// ChangeStatusRequest asks for a facility to be activated, deactivated or
+// suspended until SuspendedUntil. When deactivating or suspending it, the
+// claims on the shifts it hides are cancelled if CancelClaims is set.
+type ChangeStatusRequest struct {
+	Action         domain.FacilityAction
+	CancelClaims   bool
+	FacilityID     int64
+	SuspendedUntil *time.Time
+}
+
+type ChangeStatusResponse struct {
+	Change domain.FacilityLifecycleChange
+}
+
+func (cs *changeStatus) ChangeStatus(ctx context.Context, req ChangeStatusRequest) (*ChangeStatusResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to change the status of a facility")
+	}
+
+	now := time.Now()
+
+	switch req.Action {
+	case domain.FacilityActionActivate:
+		if req.CancelClaims {
+			return nil, fmt.Errorf("%w: claims can't be cancelled when activating", domain.ErrInvalidFacilityStatus)
+		}
+	case domain.FacilityActionDeactivate:
+	case domain.FacilityActionSuspend:
+		if req.SuspendedUntil == nil {
+			return nil, fmt.Errorf("%w: until is required to suspend", domain.ErrInvalidFacilityStatus)
+		}
+
+		if !req.SuspendedUntil.After(now) {
+			return nil, fmt.Errorf("%w: until must be in the future", domain.ErrInvalidFacilityStatus)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown action %q", domain.ErrInvalidFacilityStatus, req.Action)
+	}
+
+	if req.Action != domain.FacilityActionSuspend && req.SuspendedUntil != nil {
+		return nil, fmt.Errorf("%w: until is only allowed when suspending", domain.ErrInvalidFacilityStatus)
+	}
+
+	change, err := cs.facilityRepository.ChangeFacilityStatus(ctx, req.FacilityID, req.Action, req.SuspendedUntil, req.CancelClaims, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangeStatusResponse{
+		Change: *change,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/change_status.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockChangeStatus is a mock of ChangeStatus interface.
+type MockChangeStatus struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeStatusMockRecorder
+}
+
+// MockChangeStatusMockRecorder is the mock recorder for MockChangeStatus.
+type MockChangeStatusMockRecorder struct {
+	mock *MockChangeStatus
+}
+
+// NewMockChangeStatus creates a new mock instance.
+func NewMockChangeStatus(ctrl *gomock.Controller) *MockChangeStatus {
+	mock := &MockChangeStatus{ctrl: ctrl}
+	mock.recorder = &MockChangeStatusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeStatus) EXPECT() *MockChangeStatusMockRecorder {
+	return m.recorder
+}
+
+// ChangeStatus mocks base method.
+func (m *MockChangeStatus) ChangeStatus(ctx context.Context, req ChangeStatusRequest) (*ChangeStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, req)
+	ret0, _ := ret[0].(*ChangeStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockChangeStatusMockRecorder) ChangeStatus(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockChangeStatus)(nil).ChangeStatus), ctx, req)
+}
@@ -0,0 +1,104 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeFacilityID := int64(7)
+	future := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	invalidRequests := map[string]facility.ChangeStatusRequest{
+		"no facility": {
+			Action: domain.FacilityActionActivate,
+		},
+		"unknown action": {
+			Action:     domain.FacilityAction("CLOSE"),
+			FacilityID: fakeFacilityID,
+		},
+		"cancelling claims when activating": {
+			Action:       domain.FacilityActionActivate,
+			CancelClaims: true,
+			FacilityID:   fakeFacilityID,
+		},
+		"suspending without an end": {
+			Action:     domain.FacilityActionSuspend,
+			FacilityID: fakeFacilityID,
+		},
+		"suspending until the past": {
+			Action:         domain.FacilityActionSuspend,
+			FacilityID:     fakeFacilityID,
+			SuspendedUntil: &past,
+		},
+		"deactivating until a date": {
+			Action:         domain.FacilityActionDeactivate,
+			FacilityID:     fakeFacilityID,
+			SuspendedUntil: &future,
+		},
+	}
+
+	for name, req := range invalidRequests {
+		req := req
+
+		t.Run("should return error when "+name, func(t *testing.T) {
+			t.Parallel()
+
+			us := facility.NewChangeStatus(nil)
+			resp, err := us.ChangeStatus(ctx, req)
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+		})
+	}
+
+	t.Run("should successfully suspend the facility, cancelling claims after now", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fakeChange := domain.FacilityLifecycleChange{
+			AffectedWorkers: []domain.AffectedWorker{{ShiftIDs: []int64{10}, WorkerID: 101}},
+			Change: domain.FacilityStatusChange{
+				Action:          domain.FacilityActionSuspend,
+				CancelledClaims: true,
+				FacilityID:      fakeFacilityID,
+				ID:              1,
+				SuspendedUntil:  &future,
+			},
+			Status: domain.FacilityStatus{IsActive: true, SuspendedUntil: &future},
+		}
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			ChangeFacilityStatus(ctx, fakeFacilityID, domain.FacilityActionSuspend, &future, true, gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, _ int64, _ domain.FacilityAction, _ *time.Time, _ bool, after time.Time) (*domain.FacilityLifecycleChange, error) {
+				assert.WithinDuration(t, time.Now(), after, time.Minute)
+
+				return &fakeChange, nil
+			})
+
+		us := facility.NewChangeStatus(mockFacilityRepository)
+		resp, err := us.ChangeStatus(ctx, facility.ChangeStatusRequest{
+			Action:         domain.FacilityActionSuspend,
+			CancelClaims:   true,
+			FacilityID:     fakeFacilityID,
+			SuspendedUntil: &future,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.ChangeStatusResponse{Change: fakeChange}, resp)
+	})
+}
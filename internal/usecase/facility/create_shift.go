@@ -0,0 +1,65 @@
+package facility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/create_shift_mock.go -package=facility -source=internal/usecase/facility/create_shift.go CreateShift
+type CreateShift interface {
+	CreateShift(ctx context.Context, req CreateShiftRequest) (*CreateShiftResponse, error)
+}
+
+type createShift struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewCreateShift(fr domain.FacilityRepository) CreateShift {
+	return &createShift{
+		facilityRepository: fr,
+	}
+}
+
+// CreateShiftRequest asks for an open shift of a profession to be posted at a
+// facility.
+type CreateShiftRequest struct {
+	End        time.Time
+	FacilityID int64
+	Profession domain.Profession
+	Start      time.Time
+}
+
+type CreateShiftResponse struct {
+	Shift domain.FacilityShift
+}
+
+func (cs *createShift) CreateShift(ctx context.Context, req CreateShiftRequest) (*CreateShiftResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to create a shift")
+	}
+
+	if req.Start.IsZero() || req.End.IsZero() {
+		return nil, fmt.Errorf("%w: start and end are required", domain.ErrInvalidShift)
+	}
+
+	if !req.End.After(req.Start) {
+		return nil, fmt.Errorf("%w: end must be after start", domain.ErrInvalidShift)
+	}
+
+	if !req.Profession.Valid() {
+		return nil, fmt.Errorf("%w: unknown profession %q", domain.ErrInvalidShift, req.Profession)
+	}
+
+	shift, err := cs.facilityRepository.CreateShift(ctx, req.FacilityID, req.Start, req.End, req.Profession)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateShiftResponse{
+		Shift: *shift,
+	}, nil
+}
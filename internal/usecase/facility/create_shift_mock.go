@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/create_shift.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCreateShift is a mock of CreateShift interface.
+type MockCreateShift struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateShiftMockRecorder
+}
+
+// MockCreateShiftMockRecorder is the mock recorder for MockCreateShift.
+type MockCreateShiftMockRecorder struct {
+	mock *MockCreateShift
+}
+
+// NewMockCreateShift creates a new mock instance.
+func NewMockCreateShift(ctrl *gomock.Controller) *MockCreateShift {
+	mock := &MockCreateShift{ctrl: ctrl}
+	mock.recorder = &MockCreateShiftMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateShift) EXPECT() *MockCreateShiftMockRecorder {
+	return m.recorder
+}
+
+// CreateShift mocks base method.
+func (m *MockCreateShift) CreateShift(ctx context.Context, req CreateShiftRequest) (*CreateShiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShift", ctx, req)
+	ret0, _ := ret[0].(*CreateShiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShift indicates an expected call of CreateShift.
+func (mr *MockCreateShiftMockRecorder) CreateShift(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShift", reflect.TypeOf((*MockCreateShift)(nil).CreateShift), ctx, req)
+}
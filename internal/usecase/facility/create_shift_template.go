@@ -0,0 +1,109 @@
+package facility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+// maxShiftTemplateHeadcount bounds how many identical shifts a template posts at
+// once, so materializing it over a window of dates stays reasonably sized.
+const maxShiftTemplateHeadcount = 50
+
+//go:generate mockgen -destination=internal/usecase/facility/create_shift_template_mock.go -package=facility -source=internal/usecase/facility/create_shift_template.go CreateShiftTemplate
+type CreateShiftTemplate interface {
+	CreateShiftTemplate(ctx context.Context, req CreateShiftTemplateRequest) (*CreateShiftTemplateResponse, error)
+}
+
+type createShiftTemplate struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewCreateShiftTemplate(fr domain.FacilityRepository) CreateShiftTemplate {
+	return &createShiftTemplate{
+		facilityRepository: fr,
+	}
+}
+
+// CreateShiftTemplateRequest asks for a shift template to be added to the
+// facility it belongs to.
+type CreateShiftTemplateRequest struct {
+	Template domain.ShiftTemplate
+}
+
+type CreateShiftTemplateResponse struct {
+	Template domain.ShiftTemplate
+}
+
+func (cst *createShiftTemplate) CreateShiftTemplate(ctx context.Context, req CreateShiftTemplateRequest) (*CreateShiftTemplateResponse, error) {
+	template := req.Template
+
+	if template.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to create a shift template")
+	}
+
+	if err := validateShiftTemplate(template); err != nil {
+		return nil, err
+	}
+
+	created, err := cst.facilityRepository.CreateShiftTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateShiftTemplateResponse{
+		Template: *created,
+	}, nil
+}
+
+func validateShiftTemplate(template domain.ShiftTemplate) error {
+	if len(template.DaysOfWeek) == 0 {
+		return fmt.Errorf("%w: at least one day of the week is required", domain.ErrInvalidShiftTemplate)
+	}
+
+	seen := map[time.Weekday]bool{}
+	for _, day := range template.DaysOfWeek {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("%w: unknown day of the week %d", domain.ErrInvalidShiftTemplate, day)
+		}
+
+		if seen[day] {
+			return fmt.Errorf("%w: %s is repeated", domain.ErrInvalidShiftTemplate, day)
+		}
+
+		seen[day] = true
+	}
+
+	if !template.StartTime.IsValid() {
+		return fmt.Errorf("%w: start time is invalid", domain.ErrInvalidShiftTemplate)
+	}
+
+	if template.Duration <= 0 || template.Duration > 24*time.Hour || template.Duration%time.Minute != 0 {
+		return fmt.Errorf("%w: duration must be whole minutes, up to 24h", domain.ErrInvalidShiftTemplate)
+	}
+
+	if template.Headcount < 1 || template.Headcount > maxShiftTemplateHeadcount {
+		return fmt.Errorf("%w: headcount must be between 1 and %d", domain.ErrInvalidShiftTemplate, maxShiftTemplateHeadcount)
+	}
+
+	if template.Count != nil && *template.Count < 1 {
+		return fmt.Errorf("%w: count must be positive", domain.ErrInvalidShiftTemplate)
+	}
+
+	if template.Count != nil && template.StartsOn == nil {
+		return fmt.Errorf("%w: starts_on is required to count occurrences from", domain.ErrInvalidShiftTemplate)
+	}
+
+	if template.StartsOn != nil && !template.StartsOn.IsValid() {
+		return fmt.Errorf("%w: starts_on is invalid", domain.ErrInvalidShiftTemplate)
+	}
+
+	if !template.Profession.Valid() {
+		return fmt.Errorf("%w: unknown profession %q", domain.ErrInvalidShiftTemplate, template.Profession)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/create_shift_template.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCreateShiftTemplate is a mock of CreateShiftTemplate interface.
+type MockCreateShiftTemplate struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateShiftTemplateMockRecorder
+}
+
+// MockCreateShiftTemplateMockRecorder is the mock recorder for MockCreateShiftTemplate.
+type MockCreateShiftTemplateMockRecorder struct {
+	mock *MockCreateShiftTemplate
+}
+
+// NewMockCreateShiftTemplate creates a new mock instance.
+func NewMockCreateShiftTemplate(ctrl *gomock.Controller) *MockCreateShiftTemplate {
+	mock := &MockCreateShiftTemplate{ctrl: ctrl}
+	mock.recorder = &MockCreateShiftTemplateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateShiftTemplate) EXPECT() *MockCreateShiftTemplateMockRecorder {
+	return m.recorder
+}
+
+// CreateShiftTemplate mocks base method.
+func (m *MockCreateShiftTemplate) CreateShiftTemplate(ctx context.Context, req CreateShiftTemplateRequest) (*CreateShiftTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShiftTemplate", ctx, req)
+	ret0, _ := ret[0].(*CreateShiftTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShiftTemplate indicates an expected call of CreateShiftTemplate.
+func (mr *MockCreateShiftTemplateMockRecorder) CreateShiftTemplate(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShiftTemplate", reflect.TypeOf((*MockCreateShiftTemplate)(nil).CreateShiftTemplate), ctx, req)
+}
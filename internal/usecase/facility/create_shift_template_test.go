@@ -0,0 +1,107 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"cloud.google.com/go/civil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateShiftTemplate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeTemplate := domain.ShiftTemplate{
+		DaysOfWeek: []time.Weekday{time.Monday, time.Friday},
+		Duration:   5 * time.Hour,
+		FacilityID: 7,
+		Headcount:  2,
+		Profession: domain.ProfessionCNA,
+		StartTime:  civil.Time{Hour: 5},
+	}
+
+	invalidTemplates := map[string]func(*domain.ShiftTemplate){
+		"no days of the week":        func(st *domain.ShiftTemplate) { st.DaysOfWeek = nil },
+		"an unknown day of the week": func(st *domain.ShiftTemplate) { st.DaysOfWeek = []time.Weekday{7} },
+		"a repeated day of the week": func(st *domain.ShiftTemplate) { st.DaysOfWeek = []time.Weekday{time.Monday, time.Monday} },
+		"an invalid start time":      func(st *domain.ShiftTemplate) { st.StartTime = civil.Time{Hour: 25} },
+		"no duration":                func(st *domain.ShiftTemplate) { st.Duration = 0 },
+		"a duration over a day":      func(st *domain.ShiftTemplate) { st.Duration = 25 * time.Hour },
+		"a duration in seconds":      func(st *domain.ShiftTemplate) { st.Duration = 5*time.Hour + time.Second },
+		"no headcount":               func(st *domain.ShiftTemplate) { st.Headcount = 0 },
+		"too large a headcount":      func(st *domain.ShiftTemplate) { st.Headcount = 51 },
+		"an unknown profession":      func(st *domain.ShiftTemplate) { st.Profession = domain.Profession("DOCTOR") },
+		"a count of zero": func(st *domain.ShiftTemplate) {
+			count := 0
+			st.Count = &count
+			st.StartsOn = &civil.Date{Year: 2030, Month: time.January, Day: 7}
+		},
+		"a count without starts_on": func(st *domain.ShiftTemplate) {
+			count := 10
+			st.Count = &count
+		},
+		"an invalid starts_on": func(st *domain.ShiftTemplate) { st.StartsOn = &civil.Date{Year: 2030, Month: time.February, Day: 30} },
+	}
+
+	t.Run("should return error when no facility is provided", func(t *testing.T) {
+		t.Parallel()
+
+		template := fakeTemplate
+		template.FacilityID = 0
+
+		us := facility.NewCreateShiftTemplate(nil)
+		created, err := us.CreateShiftTemplate(ctx, facility.CreateShiftTemplateRequest{
+			Template: template,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, created)
+	})
+
+	for name, invalidate := range invalidTemplates {
+		invalidate := invalidate
+
+		t.Run("should return error when given "+name, func(t *testing.T) {
+			t.Parallel()
+
+			template := fakeTemplate
+			invalidate(&template)
+
+			us := facility.NewCreateShiftTemplate(nil)
+			created, err := us.CreateShiftTemplate(ctx, facility.CreateShiftTemplateRequest{
+				Template: template,
+			})
+			assert.ErrorIs(t, err, domain.ErrInvalidShiftTemplate)
+			assert.Nil(t, created)
+		})
+	}
+
+	t.Run("should successfully create the shift template", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		created := fakeTemplate
+		created.ID = 3
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			CreateShiftTemplate(ctx, fakeTemplate).
+			Times(1).
+			Return(&created, nil)
+
+		us := facility.NewCreateShiftTemplate(mockFacilityRepository)
+		resp, err := us.CreateShiftTemplate(ctx, facility.CreateShiftTemplateRequest{
+			Template: fakeTemplate,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.CreateShiftTemplateResponse{Template: created}, resp)
+	})
+}
@@ -0,0 +1,120 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateShift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeFacilityID := int64(7)
+	fakeStart := time.Date(2030, 1, 1, 5, 0, 0, 0, time.UTC)
+	fakeEnd := fakeStart.Add(5 * time.Hour)
+	fakeShift := domain.FacilityShift{
+		Profession: domain.ProfessionCNA,
+		Shift: domain.Shift{
+			End:      fakeEnd,
+			Facility: domain.Facility{ID: fakeFacilityID, Name: "Facility", TimeZone: time.UTC},
+			ID:       10,
+			Start:    fakeStart,
+		},
+	}
+
+	t.Run("should return error when no facility is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewCreateShift(nil)
+		created, err := us.CreateShift(ctx, facility.CreateShiftRequest{
+			End:        fakeEnd,
+			Profession: domain.ProfessionCNA,
+			Start:      fakeStart,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, created)
+	})
+
+	t.Run("should return error when the shift doesn't end after it starts", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewCreateShift(nil)
+		created, err := us.CreateShift(ctx, facility.CreateShiftRequest{
+			End:        fakeStart,
+			FacilityID: fakeFacilityID,
+			Profession: domain.ProfessionCNA,
+			Start:      fakeStart,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidShift)
+		assert.Nil(t, created)
+	})
+
+	t.Run("should return error when the profession is unknown", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewCreateShift(nil)
+		created, err := us.CreateShift(ctx, facility.CreateShiftRequest{
+			End:        fakeEnd,
+			FacilityID: fakeFacilityID,
+			Profession: domain.Profession("DOCTOR"),
+			Start:      fakeStart,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidShift)
+		assert.Nil(t, created)
+	})
+
+	t.Run("should return error if the facility is inactive", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			CreateShift(ctx, fakeFacilityID, fakeStart, fakeEnd, domain.ProfessionCNA).
+			Times(1).
+			Return(nil, domain.ErrFacilityInactive)
+
+		us := facility.NewCreateShift(mockFacilityRepository)
+		created, err := us.CreateShift(ctx, facility.CreateShiftRequest{
+			End:        fakeEnd,
+			FacilityID: fakeFacilityID,
+			Profession: domain.ProfessionCNA,
+			Start:      fakeStart,
+		})
+		assert.ErrorIs(t, err, domain.ErrFacilityInactive)
+		assert.Nil(t, created)
+	})
+
+	t.Run("should successfully create the shift", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			CreateShift(ctx, fakeFacilityID, fakeStart, fakeEnd, domain.ProfessionCNA).
+			Times(1).
+			Return(&fakeShift, nil)
+
+		us := facility.NewCreateShift(mockFacilityRepository)
+		created, err := us.CreateShift(ctx, facility.CreateShiftRequest{
+			End:        fakeEnd,
+			FacilityID: fakeFacilityID,
+			Profession: domain.ProfessionCNA,
+			Start:      fakeStart,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.CreateShiftResponse{Shift: fakeShift}, created)
+	})
+}
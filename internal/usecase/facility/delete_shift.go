@@ -0,0 +1,55 @@
+package facility
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/delete_shift_mock.go -package=facility -source=internal/usecase/facility/delete_shift.go DeleteShift
+type DeleteShift interface {
+	DeleteShift(ctx context.Context, req DeleteShiftRequest) (*DeleteShiftResponse, error)
+}
+
+type deleteShift struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewDeleteShift(fr domain.FacilityRepository) DeleteShift {
+	return &deleteShift{
+		facilityRepository: fr,
+	}
+}
+
+// DeleteShiftRequest asks for a shift of a facility to be deleted. Claimed
+// shifts are only deleted when Unassign is set, releasing them from the worker
+// who claimed them.
+type DeleteShiftRequest struct {
+	FacilityID int64
+	ShiftID    int64
+	Unassign   bool
+}
+
+type DeleteShiftResponse struct {
+	Shift domain.FacilityShift
+}
+
+func (ds *deleteShift) DeleteShift(ctx context.Context, req DeleteShiftRequest) (*DeleteShiftResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to delete a shift")
+	}
+
+	if req.ShiftID == 0 {
+		return nil, errors.New("a shift_id is required to delete a shift")
+	}
+
+	shift, err := ds.facilityRepository.DeleteShift(ctx, req.FacilityID, req.ShiftID, req.Unassign)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteShiftResponse{
+		Shift: *shift,
+	}, nil
+}
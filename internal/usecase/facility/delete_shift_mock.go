@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/delete_shift.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeleteShift is a mock of DeleteShift interface.
+type MockDeleteShift struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteShiftMockRecorder
+}
+
+// MockDeleteShiftMockRecorder is the mock recorder for MockDeleteShift.
+type MockDeleteShiftMockRecorder struct {
+	mock *MockDeleteShift
+}
+
+// NewMockDeleteShift creates a new mock instance.
+func NewMockDeleteShift(ctrl *gomock.Controller) *MockDeleteShift {
+	mock := &MockDeleteShift{ctrl: ctrl}
+	mock.recorder = &MockDeleteShiftMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteShift) EXPECT() *MockDeleteShiftMockRecorder {
+	return m.recorder
+}
+
+// DeleteShift mocks base method.
+func (m *MockDeleteShift) DeleteShift(ctx context.Context, req DeleteShiftRequest) (*DeleteShiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShift", ctx, req)
+	ret0, _ := ret[0].(*DeleteShiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteShift indicates an expected call of DeleteShift.
+func (mr *MockDeleteShiftMockRecorder) DeleteShift(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShift", reflect.TypeOf((*MockDeleteShift)(nil).DeleteShift), ctx, req)
+}
@@ -0,0 +1,98 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteShift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeFacilityID := int64(7)
+	fakeShiftID := int64(10)
+	fakeStart := time.Date(2030, 1, 1, 5, 0, 0, 0, time.UTC)
+	fakeShift := domain.FacilityShift{
+		Profession: domain.ProfessionCNA,
+		Shift: domain.Shift{
+			End:      fakeStart.Add(5 * time.Hour),
+			Facility: domain.Facility{ID: fakeFacilityID, Name: "Facility", TimeZone: time.UTC},
+			ID:       fakeShiftID,
+			Start:    fakeStart,
+		},
+	}
+
+	t.Run("should return error when no facility is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewDeleteShift(nil)
+		deleted, err := us.DeleteShift(ctx, facility.DeleteShiftRequest{
+			ShiftID: fakeShiftID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, deleted)
+	})
+
+	t.Run("should return error when no shift is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewDeleteShift(nil)
+		deleted, err := us.DeleteShift(ctx, facility.DeleteShiftRequest{
+			FacilityID: fakeFacilityID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, deleted)
+	})
+
+	t.Run("should return error if the shift can't be found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			DeleteShift(ctx, fakeFacilityID, fakeShiftID, false).
+			Times(1).
+			Return(nil, domain.ErrShiftNotFound)
+
+		us := facility.NewDeleteShift(mockFacilityRepository)
+		deleted, err := us.DeleteShift(ctx, facility.DeleteShiftRequest{
+			FacilityID: fakeFacilityID,
+			ShiftID:    fakeShiftID,
+		})
+		assert.ErrorIs(t, err, domain.ErrShiftNotFound)
+		assert.Nil(t, deleted)
+	})
+
+	t.Run("should successfully delete the shift", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			DeleteShift(ctx, fakeFacilityID, fakeShiftID, true).
+			Times(1).
+			Return(&fakeShift, nil)
+
+		us := facility.NewDeleteShift(mockFacilityRepository)
+		deleted, err := us.DeleteShift(ctx, facility.DeleteShiftRequest{
+			FacilityID: fakeFacilityID,
+			ShiftID:    fakeShiftID,
+			Unassign:   true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.DeleteShiftResponse{Shift: fakeShift}, deleted)
+	})
+}
@@ -0,0 +1,76 @@
+package facility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+
+	"cloud.google.com/go/civil"
+)
+
+// maxMaterializationDays bounds the window of dates a shift template is
+// materialized over at once.
+const maxMaterializationDays = 92
+
+//go:generate mockgen -destination=internal/usecase/facility/materialize_shift_template_mock.go -package=facility -source=internal/usecase/facility/materialize_shift_template.go MaterializeShiftTemplate
+type MaterializeShiftTemplate interface {
+	MaterializeShiftTemplate(ctx context.Context, req MaterializeShiftTemplateRequest) (*MaterializeShiftTemplateResponse, error)
+}
+
+type materializeShiftTemplate struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewMaterializeShiftTemplate(fr domain.FacilityRepository) MaterializeShiftTemplate {
+	return &materializeShiftTemplate{
+		facilityRepository: fr,
+	}
+}
+
+// MaterializeShiftTemplateRequest asks for the shifts of a template starting
+// on the dates between Start and End, both inclusive and in the time zone of
+// the facility, to be posted. Shifts which already started are left out.
+type MaterializeShiftTemplateRequest struct {
+	End        civil.Date
+	FacilityID int64
+	Start      civil.Date
+	TemplateID int64
+}
+
+type MaterializeShiftTemplateResponse struct {
+	Materialization domain.ShiftMaterialization
+}
+
+func (mst *materializeShiftTemplate) MaterializeShiftTemplate(ctx context.Context, req MaterializeShiftTemplateRequest) (*MaterializeShiftTemplateResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to materialize a shift template")
+	}
+
+	if req.TemplateID == 0 {
+		return nil, errors.New("a template_id is required to materialize a shift template")
+	}
+
+	if !req.Start.IsValid() || !req.End.IsValid() {
+		return nil, fmt.Errorf("%w: start and end dates are required", domain.ErrInvalidDateWindow)
+	}
+
+	if req.End.Before(req.Start) {
+		return nil, fmt.Errorf("%w: end is before start", domain.ErrInvalidDateWindow)
+	}
+
+	if req.End.DaysSince(req.Start) >= maxMaterializationDays {
+		return nil, fmt.Errorf("%w: at most %d days can be materialized at once", domain.ErrInvalidDateWindow, maxMaterializationDays)
+	}
+
+	materialization, err := mst.facilityRepository.MaterializeShiftTemplate(ctx, req.FacilityID, req.TemplateID, req.Start, req.End, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaterializeShiftTemplateResponse{
+		Materialization: *materialization,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/materialize_shift_template.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMaterializeShiftTemplate is a mock of MaterializeShiftTemplate interface.
+type MockMaterializeShiftTemplate struct {
+	ctrl     *gomock.Controller
+	recorder *MockMaterializeShiftTemplateMockRecorder
+}
+
+// MockMaterializeShiftTemplateMockRecorder is the mock recorder for MockMaterializeShiftTemplate.
+type MockMaterializeShiftTemplateMockRecorder struct {
+	mock *MockMaterializeShiftTemplate
+}
+
+// NewMockMaterializeShiftTemplate creates a new mock instance.
+func NewMockMaterializeShiftTemplate(ctrl *gomock.Controller) *MockMaterializeShiftTemplate {
+	mock := &MockMaterializeShiftTemplate{ctrl: ctrl}
+	mock.recorder = &MockMaterializeShiftTemplateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMaterializeShiftTemplate) EXPECT() *MockMaterializeShiftTemplateMockRecorder {
+	return m.recorder
+}
+
+// MaterializeShiftTemplate mocks base method.
+func (m *MockMaterializeShiftTemplate) MaterializeShiftTemplate(ctx context.Context, req MaterializeShiftTemplateRequest) (*MaterializeShiftTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaterializeShiftTemplate", ctx, req)
+	ret0, _ := ret[0].(*MaterializeShiftTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaterializeShiftTemplate indicates an expected call of MaterializeShiftTemplate.
+func (mr *MockMaterializeShiftTemplateMockRecorder) MaterializeShiftTemplate(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterializeShiftTemplate", reflect.TypeOf((*MockMaterializeShiftTemplate)(nil).MaterializeShiftTemplate), ctx, req)
+}
@@ -0,0 +1,118 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"cloud.google.com/go/civil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaterializeShiftTemplate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeFacilityID := int64(7)
+	fakeTemplateID := int64(3)
+	fakeStart := civil.Date{Year: 2030, Month: time.January, Day: 1}
+	fakeEnd := civil.Date{Year: 2030, Month: time.January, Day: 31}
+
+	t.Run("should return error when no template is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewMaterializeShiftTemplate(nil)
+		materialized, err := us.MaterializeShiftTemplate(ctx, facility.MaterializeShiftTemplateRequest{
+			End:        fakeEnd,
+			FacilityID: fakeFacilityID,
+			Start:      fakeStart,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, materialized)
+	})
+
+	t.Run("should return error when the window ends before it starts", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewMaterializeShiftTemplate(nil)
+		materialized, err := us.MaterializeShiftTemplate(ctx, facility.MaterializeShiftTemplateRequest{
+			End:        fakeStart,
+			FacilityID: fakeFacilityID,
+			Start:      fakeEnd,
+			TemplateID: fakeTemplateID,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidDateWindow)
+		assert.Nil(t, materialized)
+	})
+
+	t.Run("should return error when the window is too large", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewMaterializeShiftTemplate(nil)
+		materialized, err := us.MaterializeShiftTemplate(ctx, facility.MaterializeShiftTemplateRequest{
+			End:        fakeStart.AddDays(92),
+			FacilityID: fakeFacilityID,
+			Start:      fakeStart,
+			TemplateID: fakeTemplateID,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidDateWindow)
+		assert.Nil(t, materialized)
+	})
+
+	t.Run("should return error if the template can't be found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			MaterializeShiftTemplate(ctx, fakeFacilityID, fakeTemplateID, fakeStart, fakeEnd, gomock.Any()).
+			Times(1).
+			Return(nil, domain.ErrShiftTemplateNotFound)
+
+		us := facility.NewMaterializeShiftTemplate(mockFacilityRepository)
+		materialized, err := us.MaterializeShiftTemplate(ctx, facility.MaterializeShiftTemplateRequest{
+			End:        fakeEnd,
+			FacilityID: fakeFacilityID,
+			Start:      fakeStart,
+			TemplateID: fakeTemplateID,
+		})
+		assert.ErrorIs(t, err, domain.ErrShiftTemplateNotFound)
+		assert.Nil(t, materialized)
+	})
+
+	t.Run("should successfully materialize the template", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fakeMaterialization := domain.ShiftMaterialization{
+			Created:  []domain.FacilityShift{{Profession: domain.ProfessionCNA, Shift: domain.Shift{ID: 10}}},
+			Existing: 4,
+		}
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			MaterializeShiftTemplate(ctx, fakeFacilityID, fakeTemplateID, fakeStart, fakeEnd, gomock.Any()).
+			Times(1).
+			Return(&fakeMaterialization, nil)
+
+		us := facility.NewMaterializeShiftTemplate(mockFacilityRepository)
+		materialized, err := us.MaterializeShiftTemplate(ctx, facility.MaterializeShiftTemplateRequest{
+			End:        fakeEnd,
+			FacilityID: fakeFacilityID,
+			Start:      fakeStart,
+			TemplateID: fakeTemplateID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.MaterializeShiftTemplateResponse{Materialization: fakeMaterialization}, materialized)
+	})
+}
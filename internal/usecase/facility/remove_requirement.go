@@ -0,0 +1,55 @@
+package facility
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/remove_requirement_mock.go -package=facility -source=internal/usecase/facility/remove_requirement.go RemoveRequirement
+type RemoveRequirement interface {
+	RemoveRequirement(ctx context.Context, req RemoveRequirementRequest) (*RemoveRequirementResponse, error)
+}
+
+type removeRequirement struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewRemoveRequirement(fr domain.FacilityRepository) RemoveRequirement {
+	return &removeRequirement{
+		facilityRepository: fr,
+	}
+}
+
+// RemoveRequirementRequest asks for a requirement of a facility to be removed.
+// On dry runs, the impact of the removal is reported without removing it.
+type RemoveRequirementRequest struct {
+	DryRun        bool
+	FacilityID    int64
+	RequirementID int64
+}
+
+type RemoveRequirementResponse struct {
+	Change domain.RequirementChange
+}
+
+func (rr *removeRequirement) RemoveRequirement(ctx context.Context, req RemoveRequirementRequest) (*RemoveRequirementResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to remove a requirement")
+	}
+
+	if req.RequirementID == 0 {
+		return nil, errors.New("a requirement_id is required to remove a requirement")
+	}
+
+	change, err := rr.facilityRepository.RemoveFacilityRequirement(ctx, req.FacilityID, req.RequirementID, time.Now(), req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoveRequirementResponse{
+		Change: *change,
+	}, nil
+}
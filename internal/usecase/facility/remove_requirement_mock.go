@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/remove_requirement.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRemoveRequirement is a mock of RemoveRequirement interface.
+type MockRemoveRequirement struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveRequirementMockRecorder
+}
+
+// MockRemoveRequirementMockRecorder is the mock recorder for MockRemoveRequirement.
+type MockRemoveRequirementMockRecorder struct {
+	mock *MockRemoveRequirement
+}
+
+// NewMockRemoveRequirement creates a new mock instance.
+func NewMockRemoveRequirement(ctrl *gomock.Controller) *MockRemoveRequirement {
+	mock := &MockRemoveRequirement{ctrl: ctrl}
+	mock.recorder = &MockRemoveRequirementMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveRequirement) EXPECT() *MockRemoveRequirementMockRecorder {
+	return m.recorder
+}
+
+// RemoveRequirement mocks base method.
+func (m *MockRemoveRequirement) RemoveRequirement(ctx context.Context, req RemoveRequirementRequest) (*RemoveRequirementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRequirement", ctx, req)
+	ret0, _ := ret[0].(*RemoveRequirementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRequirement indicates an expected call of RemoveRequirement.
+func (mr *MockRemoveRequirementMockRecorder) RemoveRequirement(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRequirement", reflect.TypeOf((*MockRemoveRequirement)(nil).RemoveRequirement), ctx, req)
+}
@@ -0,0 +1,74 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveRequirement(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeFacilityID := int64(7)
+	fakeRequirementID := int64(10)
+	fakeChange := domain.RequirementChange{
+		Impact: []domain.EligibilityImpact{
+			{Gained: 5, Profession: domain.ProfessionLVN},
+		},
+		Requirement: domain.FacilityRequirement{
+			Document:   domain.Document{ID: 4, IsActive: true, Name: "License"},
+			FacilityID: fakeFacilityID,
+			ID:         fakeRequirementID,
+		},
+	}
+
+	t.Run("should return error when no facility is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewRemoveRequirement(nil)
+		removed, err := us.RemoveRequirement(ctx, facility.RemoveRequirementRequest{
+			RequirementID: fakeRequirementID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, removed)
+	})
+
+	t.Run("should return error when no requirement is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewRemoveRequirement(nil)
+		removed, err := us.RemoveRequirement(ctx, facility.RemoveRequirementRequest{
+			FacilityID: fakeFacilityID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, removed)
+	})
+
+	t.Run("should successfully remove the requirement", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			RemoveFacilityRequirement(ctx, fakeFacilityID, fakeRequirementID, gomock.Any(), false).
+			Times(1).
+			Return(&fakeChange, nil)
+
+		us := facility.NewRemoveRequirement(mockFacilityRepository)
+		removed, err := us.RemoveRequirement(ctx, facility.RemoveRequirementRequest{
+			FacilityID:    fakeFacilityID,
+			RequirementID: fakeRequirementID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.RemoveRequirementResponse{Change: fakeChange}, removed)
+	})
+}
@@ -0,0 +1,46 @@
+package facility
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/requirements_mock.go -package=facility -source=internal/usecase/facility/requirements.go Requirements
+type Requirements interface {
+	GetRequirements(ctx context.Context, req GetRequirementsRequest) (*GetRequirementsResponse, error)
+}
+
+type requirements struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewRequirements(fr domain.FacilityRepository) Requirements {
+	return &requirements{
+		facilityRepository: fr,
+	}
+}
+
+type GetRequirementsRequest struct {
+	FacilityID int64
+}
+
+type GetRequirementsResponse struct {
+	Requirements []domain.FacilityRequirement
+}
+
+func (r *requirements) GetRequirements(ctx context.Context, req GetRequirementsRequest) (*GetRequirementsResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to get the requirements of a facility")
+	}
+
+	requirements, err := r.facilityRepository.GetFacilityRequirements(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetRequirementsResponse{
+		Requirements: requirements,
+	}, nil
+}
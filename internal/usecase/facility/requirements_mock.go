@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/requirements.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRequirements is a mock of Requirements interface.
+type MockRequirements struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequirementsMockRecorder
+}
+
+// MockRequirementsMockRecorder is the mock recorder for MockRequirements.
+type MockRequirementsMockRecorder struct {
+	mock *MockRequirements
+}
+
+// NewMockRequirements creates a new mock instance.
+func NewMockRequirements(ctrl *gomock.Controller) *MockRequirements {
+	mock := &MockRequirements{ctrl: ctrl}
+	mock.recorder = &MockRequirementsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequirements) EXPECT() *MockRequirementsMockRecorder {
+	return m.recorder
+}
+
+// GetRequirements mocks base method.
+func (m *MockRequirements) GetRequirements(ctx context.Context, req GetRequirementsRequest) (*GetRequirementsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequirements", ctx, req)
+	ret0, _ := ret[0].(*GetRequirementsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequirements indicates an expected call of GetRequirements.
+func (mr *MockRequirementsMockRecorder) GetRequirements(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequirements", reflect.TypeOf((*MockRequirements)(nil).GetRequirements), ctx, req)
+}
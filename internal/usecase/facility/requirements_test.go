@@ -0,0 +1,77 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRequirements(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeFacilityID := int64(7)
+	fakeRequirements := []domain.FacilityRequirement{
+		{
+			Document:   domain.Document{ID: 4, IsActive: true, Name: "License"},
+			FacilityID: fakeFacilityID,
+			ID:         10,
+		},
+	}
+
+	t.Run("should return error when no facility is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewRequirements(nil)
+		requirements, err := us.GetRequirements(ctx, facility.GetRequirementsRequest{})
+		assert.Error(t, err)
+		assert.Nil(t, requirements)
+	})
+
+	t.Run("should return error if the facility does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			GetFacilityRequirements(ctx, fakeFacilityID).
+			Times(1).
+			Return(nil, domain.ErrFacilityNotFound)
+
+		us := facility.NewRequirements(mockFacilityRepository)
+		requirements, err := us.GetRequirements(ctx, facility.GetRequirementsRequest{
+			FacilityID: fakeFacilityID,
+		})
+		assert.ErrorIs(t, err, domain.ErrFacilityNotFound)
+		assert.Nil(t, requirements)
+	})
+
+	t.Run("should successfully return the requirements of the facility", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			GetFacilityRequirements(ctx, fakeFacilityID).
+			Times(1).
+			Return(fakeRequirements, nil)
+
+		us := facility.NewRequirements(mockFacilityRepository)
+		requirements, err := us.GetRequirements(ctx, facility.GetRequirementsRequest{
+			FacilityID: fakeFacilityID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.GetRequirementsResponse{Requirements: fakeRequirements}, requirements)
+	})
+}
@@ -0,0 +1,46 @@
+package facility
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/shift_templates_mock.go -package=facility -source=internal/usecase/facility/shift_templates.go ShiftTemplates
+type ShiftTemplates interface {
+	GetShiftTemplates(ctx context.Context, req GetShiftTemplatesRequest) (*GetShiftTemplatesResponse, error)
+}
+
+type shiftTemplates struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewShiftTemplates(fr domain.FacilityRepository) ShiftTemplates {
+	return &shiftTemplates{
+		facilityRepository: fr,
+	}
+}
+
+type GetShiftTemplatesRequest struct {
+	FacilityID int64
+}
+
+type GetShiftTemplatesResponse struct {
+	Templates []domain.ShiftTemplate
+}
+
+func (st *shiftTemplates) GetShiftTemplates(ctx context.Context, req GetShiftTemplatesRequest) (*GetShiftTemplatesResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to get the shift templates of a facility")
+	}
+
+	templates, err := st.facilityRepository.GetShiftTemplates(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetShiftTemplatesResponse{
+		Templates: templates,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/shift_templates.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShiftTemplates is a mock of ShiftTemplates interface.
+type MockShiftTemplates struct {
+	ctrl     *gomock.Controller
+	recorder *MockShiftTemplatesMockRecorder
+}
+
+// MockShiftTemplatesMockRecorder is the mock recorder for MockShiftTemplates.
+type MockShiftTemplatesMockRecorder struct {
+	mock *MockShiftTemplates
+}
+
+// NewMockShiftTemplates creates a new mock instance.
+func NewMockShiftTemplates(ctrl *gomock.Controller) *MockShiftTemplates {
+	mock := &MockShiftTemplates{ctrl: ctrl}
+	mock.recorder = &MockShiftTemplatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShiftTemplates) EXPECT() *MockShiftTemplatesMockRecorder {
+	return m.recorder
+}
+
+// GetShiftTemplates mocks base method.
+func (m *MockShiftTemplates) GetShiftTemplates(ctx context.Context, req GetShiftTemplatesRequest) (*GetShiftTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShiftTemplates", ctx, req)
+	ret0, _ := ret[0].(*GetShiftTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShiftTemplates indicates an expected call of GetShiftTemplates.
+func (mr *MockShiftTemplatesMockRecorder) GetShiftTemplates(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShiftTemplates", reflect.TypeOf((*MockShiftTemplates)(nil).GetShiftTemplates), ctx, req)
+}
@@ -0,0 +1,83 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"cloud.google.com/go/civil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetShiftTemplates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeFacilityID := int64(7)
+	fakeTemplates := []domain.ShiftTemplate{
+		{
+			DaysOfWeek: []time.Weekday{time.Monday, time.Friday},
+			Duration:   5 * time.Hour,
+			FacilityID: fakeFacilityID,
+			Headcount:  2,
+			ID:         3,
+			Profession: domain.ProfessionCNA,
+			StartTime:  civil.Time{Hour: 5},
+		},
+	}
+
+	t.Run("should return error when no facility is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewShiftTemplates(nil)
+		templates, err := us.GetShiftTemplates(ctx, facility.GetShiftTemplatesRequest{})
+		assert.Error(t, err)
+		assert.Nil(t, templates)
+	})
+
+	t.Run("should return error if the facility can't be found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			GetShiftTemplates(ctx, fakeFacilityID).
+			Times(1).
+			Return(nil, domain.ErrFacilityNotFound)
+
+		us := facility.NewShiftTemplates(mockFacilityRepository)
+		templates, err := us.GetShiftTemplates(ctx, facility.GetShiftTemplatesRequest{
+			FacilityID: fakeFacilityID,
+		})
+		assert.ErrorIs(t, err, domain.ErrFacilityNotFound)
+		assert.Nil(t, templates)
+	})
+
+	t.Run("should successfully return the shift templates", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			GetShiftTemplates(ctx, fakeFacilityID).
+			Times(1).
+			Return(fakeTemplates, nil)
+
+		us := facility.NewShiftTemplates(mockFacilityRepository)
+		templates, err := us.GetShiftTemplates(ctx, facility.GetShiftTemplatesRequest{
+			FacilityID: fakeFacilityID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.GetShiftTemplatesResponse{Templates: fakeTemplates}, templates)
+	})
+}
@@ -0,0 +1,46 @@
+package facility
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/status_changes_mock.go -package=facility -source=internal/usecase/facility/status_changes.go StatusChanges
+type StatusChanges interface {
+	GetStatusChanges(ctx context.Context, req GetStatusChangesRequest) (*GetStatusChangesResponse, error)
+}
+
+type statusChanges struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewStatusChanges(fr domain.FacilityRepository) StatusChanges {
+	return &statusChanges{
+		facilityRepository: fr,
+	}
+}
+
+type GetStatusChangesRequest struct {
+	FacilityID int64
+}
+
+type GetStatusChangesResponse struct {
+	Changes []domain.FacilityStatusChange
+}
+
+func (sc *statusChanges) GetStatusChanges(ctx context.Context, req GetStatusChangesRequest) (*GetStatusChangesResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to get the status changes of a facility")
+	}
+
+	changes, err := sc.facilityRepository.GetFacilityStatusChanges(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetStatusChangesResponse{
+		Changes: changes,
+	}, nil
+}
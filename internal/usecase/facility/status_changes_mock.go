@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/status_changes.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStatusChanges is a mock of StatusChanges interface.
+type MockStatusChanges struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusChangesMockRecorder
+}
+
+// MockStatusChangesMockRecorder is the mock recorder for MockStatusChanges.
+type MockStatusChangesMockRecorder struct {
+	mock *MockStatusChanges
+}
+
+// NewMockStatusChanges creates a new mock instance.
+func NewMockStatusChanges(ctrl *gomock.Controller) *MockStatusChanges {
+	mock := &MockStatusChanges{ctrl: ctrl}
+	mock.recorder = &MockStatusChangesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusChanges) EXPECT() *MockStatusChangesMockRecorder {
+	return m.recorder
+}
+
+// GetStatusChanges mocks base method.
+func (m *MockStatusChanges) GetStatusChanges(ctx context.Context, req GetStatusChangesRequest) (*GetStatusChangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusChanges", ctx, req)
+	ret0, _ := ret[0].(*GetStatusChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusChanges indicates an expected call of GetStatusChanges.
+func (mr *MockStatusChangesMockRecorder) GetStatusChanges(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusChanges", reflect.TypeOf((*MockStatusChanges)(nil).GetStatusChanges), ctx, req)
+}
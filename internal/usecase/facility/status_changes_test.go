@@ -0,0 +1,79 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStatusChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeFacilityID := int64(7)
+	fakeChanges := []domain.FacilityStatusChange{
+		{
+			Action:     domain.FacilityActionDeactivate,
+			ChangedAt:  time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			FacilityID: fakeFacilityID,
+			ID:         1,
+		},
+	}
+
+	t.Run("should return error when no facility is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewStatusChanges(nil)
+		changes, err := us.GetStatusChanges(ctx, facility.GetStatusChangesRequest{})
+		assert.Error(t, err)
+		assert.Nil(t, changes)
+	})
+
+	t.Run("should return error if the facility can't be found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			GetFacilityStatusChanges(ctx, fakeFacilityID).
+			Times(1).
+			Return(nil, domain.ErrFacilityNotFound)
+
+		us := facility.NewStatusChanges(mockFacilityRepository)
+		changes, err := us.GetStatusChanges(ctx, facility.GetStatusChangesRequest{
+			FacilityID: fakeFacilityID,
+		})
+		assert.ErrorIs(t, err, domain.ErrFacilityNotFound)
+		assert.Nil(t, changes)
+	})
+
+	t.Run("should successfully return the status changes", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			GetFacilityStatusChanges(ctx, fakeFacilityID).
+			Times(1).
+			Return(fakeChanges, nil)
+
+		us := facility.NewStatusChanges(mockFacilityRepository)
+		changes, err := us.GetStatusChanges(ctx, facility.GetStatusChangesRequest{
+			FacilityID: fakeFacilityID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.GetStatusChangesResponse{Changes: fakeChanges}, changes)
+	})
+}
@@ -0,0 +1,67 @@
+package facility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/update_requirement_mock.go -package=facility -source=internal/usecase/facility/update_requirement.go UpdateRequirement
+type UpdateRequirement interface {
+	UpdateRequirement(ctx context.Context, req UpdateRequirementRequest) (*UpdateRequirementResponse, error)
+}
+
+type updateRequirement struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewUpdateRequirement(fr domain.FacilityRepository) UpdateRequirement {
+	return &updateRequirement{
+		facilityRepository: fr,
+	}
+}
+
+// UpdateRequirementRequest asks for a requirement of a facility to require
+// another document, or for another profession. On dry runs, the impact of the update is reported without
+// applying it.
+type UpdateRequirementRequest struct {
+	DocumentID    int64
+	DryRun        bool
+	FacilityID    int64
+	Profession    *domain.Profession
+	RequirementID int64
+}
+
+type UpdateRequirementResponse struct {
+	Change domain.RequirementChange
+}
+
+func (ur *updateRequirement) UpdateRequirement(ctx context.Context, req UpdateRequirementRequest) (*UpdateRequirementResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to update a requirement")
+	}
+
+	if req.RequirementID == 0 {
+		return nil, errors.New("a requirement_id is required to update a requirement")
+	}
+
+	if req.DocumentID == 0 {
+		return nil, errors.New("a document_id is required to update a requirement")
+	}
+
+	if req.Profession != nil && !req.Profession.Valid() {
+		return nil, fmt.Errorf("unknown profession %q", *req.Profession)
+	}
+
+	change, err := ur.facilityRepository.UpdateFacilityRequirement(ctx, req.FacilityID, req.RequirementID, req.DocumentID, req.Profession, time.Now(), req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateRequirementResponse{
+		Change: *change,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/update_requirement.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUpdateRequirement is a mock of UpdateRequirement interface.
+type MockUpdateRequirement struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateRequirementMockRecorder
+}
+
+// MockUpdateRequirementMockRecorder is the mock recorder for MockUpdateRequirement.
+type MockUpdateRequirementMockRecorder struct {
+	mock *MockUpdateRequirement
+}
+
+// NewMockUpdateRequirement creates a new mock instance.
+func NewMockUpdateRequirement(ctrl *gomock.Controller) *MockUpdateRequirement {
+	mock := &MockUpdateRequirement{ctrl: ctrl}
+	mock.recorder = &MockUpdateRequirementMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateRequirement) EXPECT() *MockUpdateRequirementMockRecorder {
+	return m.recorder
+}
+
+// UpdateRequirement mocks base method.
+func (m *MockUpdateRequirement) UpdateRequirement(ctx context.Context, req UpdateRequirementRequest) (*UpdateRequirementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRequirement", ctx, req)
+	ret0, _ := ret[0].(*UpdateRequirementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRequirement indicates an expected call of UpdateRequirement.
+func (mr *MockUpdateRequirementMockRecorder) UpdateRequirement(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequirement", reflect.TypeOf((*MockUpdateRequirement)(nil).UpdateRequirement), ctx, req)
+}
@@ -0,0 +1,101 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateRequirement(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeDocumentID := int64(4)
+	fakeFacilityID := int64(7)
+	fakeRequirementID := int64(10)
+	fakeChange := domain.RequirementChange{
+		Impact: []domain.EligibilityImpact{
+			{Gained: 1, Lost: 2, Profession: domain.ProfessionRN},
+		},
+		Requirement: domain.FacilityRequirement{
+			Document:   domain.Document{ID: fakeDocumentID, IsActive: true, Name: "License"},
+			FacilityID: fakeFacilityID,
+			ID:         fakeRequirementID,
+		},
+	}
+
+	t.Run("should return error when no requirement is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewUpdateRequirement(nil)
+		updated, err := us.UpdateRequirement(ctx, facility.UpdateRequirementRequest{
+			DocumentID: fakeDocumentID,
+			FacilityID: fakeFacilityID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("should return error when no document is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewUpdateRequirement(nil)
+		updated, err := us.UpdateRequirement(ctx, facility.UpdateRequirementRequest{
+			FacilityID:    fakeFacilityID,
+			RequirementID: fakeRequirementID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("should return error if the requirement does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			UpdateFacilityRequirement(ctx, fakeFacilityID, fakeRequirementID, fakeDocumentID, nil, gomock.Any(), false).
+			Times(1).
+			Return(nil, domain.ErrRequirementNotFound)
+
+		us := facility.NewUpdateRequirement(mockFacilityRepository)
+		updated, err := us.UpdateRequirement(ctx, facility.UpdateRequirementRequest{
+			DocumentID:    fakeDocumentID,
+			FacilityID:    fakeFacilityID,
+			RequirementID: fakeRequirementID,
+		})
+		assert.ErrorIs(t, err, domain.ErrRequirementNotFound)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("should successfully update the requirement", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			UpdateFacilityRequirement(ctx, fakeFacilityID, fakeRequirementID, fakeDocumentID, nil, gomock.Any(), false).
+			Times(1).
+			Return(&fakeChange, nil)
+
+		us := facility.NewUpdateRequirement(mockFacilityRepository)
+		updated, err := us.UpdateRequirement(ctx, facility.UpdateRequirementRequest{
+			DocumentID:    fakeDocumentID,
+			FacilityID:    fakeFacilityID,
+			RequirementID: fakeRequirementID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.UpdateRequirementResponse{Change: fakeChange}, updated)
+	})
+}
@@ -0,0 +1,75 @@
+package facility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/facility/update_shift_mock.go -package=facility -source=internal/usecase/facility/update_shift.go UpdateShift
+type UpdateShift interface {
+	UpdateShift(ctx context.Context, req UpdateShiftRequest) (*UpdateShiftResponse, error)
+}
+
+type updateShift struct {
+	facilityRepository domain.FacilityRepository
+}
+
+func NewUpdateShift(fr domain.FacilityRepository) UpdateShift {
+	return &updateShift{
+		facilityRepository: fr,
+	}
+}
+
+// UpdateShiftRequest asks for a shift of a facility to be edited. Claimed
+// shifts are only edited when Unassign is set, releasing them from the worker
+// who claimed them.
+type UpdateShiftRequest struct {
+	FacilityID int64
+	ShiftID    int64
+	Unassign   bool
+	Update     domain.ShiftUpdate
+}
+
+type UpdateShiftResponse struct {
+	Shift domain.FacilityShift
+}
+
+func (us *updateShift) UpdateShift(ctx context.Context, req UpdateShiftRequest) (*UpdateShiftResponse, error) {
+	if req.FacilityID == 0 {
+		return nil, errors.New("a facility_id is required to update a shift")
+	}
+
+	if req.ShiftID == 0 {
+		return nil, errors.New("a shift_id is required to update a shift")
+	}
+
+	update := req.Update
+
+	if update.Start == nil && update.End == nil && update.Profession == nil {
+		return nil, fmt.Errorf("%w: nothing to update", domain.ErrInvalidShift)
+	}
+
+	if (update.Start != nil && update.Start.IsZero()) || (update.End != nil && update.End.IsZero()) {
+		return nil, fmt.Errorf("%w: start and end can't be empty", domain.ErrInvalidShift)
+	}
+
+	if update.Start != nil && update.End != nil && !update.End.After(*update.Start) {
+		return nil, fmt.Errorf("%w: end must be after start", domain.ErrInvalidShift)
+	}
+
+	if update.Profession != nil && !update.Profession.Valid() {
+		return nil, fmt.Errorf("%w: unknown profession %q", domain.ErrInvalidShift, *update.Profession)
+	}
+
+	shift, err := us.facilityRepository.UpdateShift(ctx, req.FacilityID, req.ShiftID, update, req.Unassign)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateShiftResponse{
+		Shift: *shift,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/facility/update_shift.go
+
+// Package facility is a generated GoMock package.
+package facility
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUpdateShift is a mock of UpdateShift interface.
+type MockUpdateShift struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateShiftMockRecorder
+}
+
+// MockUpdateShiftMockRecorder is the mock recorder for MockUpdateShift.
+type MockUpdateShiftMockRecorder struct {
+	mock *MockUpdateShift
+}
+
+// NewMockUpdateShift creates a new mock instance.
+func NewMockUpdateShift(ctrl *gomock.Controller) *MockUpdateShift {
+	mock := &MockUpdateShift{ctrl: ctrl}
+	mock.recorder = &MockUpdateShiftMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateShift) EXPECT() *MockUpdateShiftMockRecorder {
+	return m.recorder
+}
+
+// UpdateShift mocks base method.
+func (m *MockUpdateShift) UpdateShift(ctx context.Context, req UpdateShiftRequest) (*UpdateShiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShift", ctx, req)
+	ret0, _ := ret[0].(*UpdateShiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShift indicates an expected call of UpdateShift.
+func (mr *MockUpdateShiftMockRecorder) UpdateShift(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShift", reflect.TypeOf((*MockUpdateShift)(nil).UpdateShift), ctx, req)
+}
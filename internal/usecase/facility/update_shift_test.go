@@ -0,0 +1,121 @@
+package facility_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/facility"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateShift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeFacilityID := int64(7)
+	fakeShiftID := int64(10)
+	fakeStart := time.Date(2030, 1, 1, 5, 0, 0, 0, time.UTC)
+	fakeEnd := fakeStart.Add(5 * time.Hour)
+	fakeShift := domain.FacilityShift{
+		Profession: domain.ProfessionCNA,
+		Shift: domain.Shift{
+			End:      fakeEnd,
+			Facility: domain.Facility{ID: fakeFacilityID, Name: "Facility", TimeZone: time.UTC},
+			ID:       fakeShiftID,
+			Start:    fakeStart,
+		},
+	}
+
+	t.Run("should return error when no shift is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewUpdateShift(nil)
+		updated, err := us.UpdateShift(ctx, facility.UpdateShiftRequest{
+			FacilityID: fakeFacilityID,
+			Update:     domain.ShiftUpdate{Start: &fakeStart},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("should return error when there is nothing to update", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewUpdateShift(nil)
+		updated, err := us.UpdateShift(ctx, facility.UpdateShiftRequest{
+			FacilityID: fakeFacilityID,
+			ShiftID:    fakeShiftID,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidShift)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("should return error when the shift doesn't end after it starts", func(t *testing.T) {
+		t.Parallel()
+
+		us := facility.NewUpdateShift(nil)
+		updated, err := us.UpdateShift(ctx, facility.UpdateShiftRequest{
+			FacilityID: fakeFacilityID,
+			ShiftID:    fakeShiftID,
+			Update:     domain.ShiftUpdate{End: &fakeStart, Start: &fakeEnd},
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidShift)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("should return error if the shift is claimed", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		update := domain.ShiftUpdate{Start: &fakeStart}
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			UpdateShift(ctx, fakeFacilityID, fakeShiftID, update, false).
+			Times(1).
+			Return(nil, domain.ErrShiftAlreadyClaimed)
+
+		us := facility.NewUpdateShift(mockFacilityRepository)
+		updated, err := us.UpdateShift(ctx, facility.UpdateShiftRequest{
+			FacilityID: fakeFacilityID,
+			ShiftID:    fakeShiftID,
+			Update:     update,
+		})
+		assert.ErrorIs(t, err, domain.ErrShiftAlreadyClaimed)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("should successfully update the shift, unassigning the worker", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		profession := domain.ProfessionCNA
+		update := domain.ShiftUpdate{End: &fakeEnd, Profession: &profession, Start: &fakeStart}
+
+		mockFacilityRepository := domain.NewMockFacilityRepository(ctrl)
+		mockFacilityRepository.
+			EXPECT().
+			UpdateShift(ctx, fakeFacilityID, fakeShiftID, update, true).
+			Times(1).
+			Return(&fakeShift, nil)
+
+		us := facility.NewUpdateShift(mockFacilityRepository)
+		updated, err := us.UpdateShift(ctx, facility.UpdateShiftRequest{
+			FacilityID: fakeFacilityID,
+			ShiftID:    fakeShiftID,
+			Unassign:   true,
+			Update:     update,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facility.UpdateShiftResponse{Shift: fakeShift}, updated)
+	})
+}
@@ -0,0 +1,65 @@
+package shift
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/shift/eligible_workers_mock.go -package=shift -source=internal/usecase/shift/eligible_workers.go EligibleWorkers
+type EligibleWorkers interface {
+	GetEligibleWorkers(ctx context.Context, req GetEligibleWorkersRequest) (*GetEligibleWorkersResponse, error)
+}
+
+type eligibleWorkers struct {
+	shiftRepository domain.ShiftRepository
+}
+
+func NewEligibleWorkers(sr domain.ShiftRepository) EligibleWorkers {
+	return &eligibleWorkers{
+		shiftRepository: sr,
+	}
+}
+
+type GetEligibleWorkersRequest struct {
+	Cursor  *cursor.Cursor
+	Limit   int
+	ShiftID int64
+}
+
+// GetEligibleWorkersResponse holds a page of workers in ascending order of ID.
+// The cursors are nil when there are no pages in their direction.
+type GetEligibleWorkersResponse struct {
+	NextCursor *cursor.Cursor
+	PrevCursor *cursor.Cursor
+	Workers    []domain.Worker
+}
+
+func (ew *eligibleWorkers) GetEligibleWorkers(ctx context.Context, req GetEligibleWorkersRequest) (*GetEligibleWorkersResponse, error) {
+	if req.ShiftID == 0 {
+		return nil, errors.New("a shift_id is required to get the workers eligible for a shift")
+	}
+
+	page, err := cursor.Paginate(
+		req.Cursor,
+		req.Limit,
+		func(limit int) ([]domain.Worker, error) {
+			return ew.shiftRepository.GetEligibleWorkers(ctx, req.Cursor, limit, req.ShiftID)
+		},
+		func(w domain.Worker) []string {
+			return []string{strconv.FormatInt(w.ID, 10)}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetEligibleWorkersResponse{
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		Workers:    page.Rows,
+	}, nil
+}
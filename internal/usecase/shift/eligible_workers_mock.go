@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/shift/eligible_workers.go
+
+// Package shift is a generated GoMock package.
+package shift
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEligibleWorkers is a mock of EligibleWorkers interface.
+type MockEligibleWorkers struct {
+	ctrl     *gomock.Controller
+	recorder *MockEligibleWorkersMockRecorder
+}
+
+// MockEligibleWorkersMockRecorder is the mock recorder for MockEligibleWorkers.
+type MockEligibleWorkersMockRecorder struct {
+	mock *MockEligibleWorkers
+}
+
+// NewMockEligibleWorkers creates a new mock instance.
+func NewMockEligibleWorkers(ctrl *gomock.Controller) *MockEligibleWorkers {
+	mock := &MockEligibleWorkers{ctrl: ctrl}
+	mock.recorder = &MockEligibleWorkersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEligibleWorkers) EXPECT() *MockEligibleWorkersMockRecorder {
+	return m.recorder
+}
+
+// GetEligibleWorkers mocks base method.
+func (m *MockEligibleWorkers) GetEligibleWorkers(ctx context.Context, req GetEligibleWorkersRequest) (*GetEligibleWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEligibleWorkers", ctx, req)
+	ret0, _ := ret[0].(*GetEligibleWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEligibleWorkers indicates an expected call of GetEligibleWorkers.
+func (mr *MockEligibleWorkersMockRecorder) GetEligibleWorkers(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEligibleWorkers", reflect.TypeOf((*MockEligibleWorkers)(nil).GetEligibleWorkers), ctx, req)
+}
@@ -0,0 +1,130 @@
+package shift_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/cursor"
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/shift"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEligibleWorkers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeShiftID := int64(10)
+	fakeWorkers := []domain.Worker{
+		{ID: 1, Name: "Ann", Profession: domain.ProfessionCNA},
+		{ID: 2, Name: "Bob", Profession: domain.ProfessionCNA},
+		{ID: 3, Name: "Cid", Profession: domain.ProfessionCNA},
+	}
+
+	t.Run("should return error when no shift is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := shift.NewEligibleWorkers(nil)
+		workers, err := us.GetEligibleWorkers(ctx, shift.GetEligibleWorkersRequest{})
+		assert.Error(t, err)
+		assert.Nil(t, workers)
+	})
+
+	t.Run("should return error if the shift can't be found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShiftRepository := domain.NewMockShiftRepository(ctrl)
+		mockShiftRepository.
+			EXPECT().
+			GetEligibleWorkers(ctx, nil, cursor.DefaultLimit+1, fakeShiftID).
+			Times(1).
+			Return(nil, domain.ErrShiftNotFound)
+
+		us := shift.NewEligibleWorkers(mockShiftRepository)
+		workers, err := us.GetEligibleWorkers(ctx, shift.GetEligibleWorkersRequest{
+			ShiftID: fakeShiftID,
+		})
+		assert.ErrorIs(t, err, domain.ErrShiftNotFound)
+		assert.Nil(t, workers)
+	})
+
+	t.Run("should return an empty page when no worker is eligible", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShiftRepository := domain.NewMockShiftRepository(ctrl)
+		mockShiftRepository.
+			EXPECT().
+			GetEligibleWorkers(ctx, nil, cursor.DefaultLimit+1, fakeShiftID).
+			Times(1).
+			Return([]domain.Worker{}, nil)
+
+		us := shift.NewEligibleWorkers(mockShiftRepository)
+		workers, err := us.GetEligibleWorkers(ctx, shift.GetEligibleWorkersRequest{
+			ShiftID: fakeShiftID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &shift.GetEligibleWorkersResponse{}, workers)
+	})
+
+	t.Run("should return the first page with a next cursor", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShiftRepository := domain.NewMockShiftRepository(ctrl)
+		mockShiftRepository.
+			EXPECT().
+			GetEligibleWorkers(ctx, nil, 3, fakeShiftID).
+			Times(1).
+			Return(fakeWorkers, nil)
+
+		us := shift.NewEligibleWorkers(mockShiftRepository)
+		workers, err := us.GetEligibleWorkers(ctx, shift.GetEligibleWorkersRequest{
+			Limit:   2,
+			ShiftID: fakeShiftID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &shift.GetEligibleWorkersResponse{
+			NextCursor: cursor.New(cursor.DirectionAfter, "2"),
+			Workers:    fakeWorkers[:2],
+		}, workers)
+	})
+
+	t.Run("should return a previous page with both cursors", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		before := cursor.New(cursor.DirectionBefore, "4")
+
+		mockShiftRepository := domain.NewMockShiftRepository(ctrl)
+		mockShiftRepository.
+			EXPECT().
+			GetEligibleWorkers(ctx, before, 3, fakeShiftID).
+			Times(1).
+			Return(fakeWorkers, nil)
+
+		us := shift.NewEligibleWorkers(mockShiftRepository)
+		workers, err := us.GetEligibleWorkers(ctx, shift.GetEligibleWorkersRequest{
+			Cursor:  before,
+			Limit:   2,
+			ShiftID: fakeShiftID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &shift.GetEligibleWorkersResponse{
+			NextCursor: cursor.New(cursor.DirectionAfter, "3"),
+			PrevCursor: cursor.New(cursor.DirectionBefore, "2"),
+			Workers:    fakeWorkers[1:],
+		}, workers)
+	})
+}
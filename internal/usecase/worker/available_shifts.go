@@ -3,8 +3,9 @@ package worker
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
-	"strconv"
+	"time"
 
 	"github.com/rodrigosdo/facilities-api/internal/cursor"
 	"github.com/rodrigosdo/facilities-api/internal/domain"
@@ -12,9 +13,18 @@ import (
 	"cloud.google.com/go/civil"
 )
 
+type GroupBy string
+
+// The time zones furthest ahead of and behind UTC, bounding the dates an
+// instant may fall on in any facility's time zone.
+var (
+	easternmostZone = time.FixedZone("UTC+14", 14*60*60)
+	westernmostZone = time.FixedZone("UTC-12", -12*60*60)
+)
+
 const (
-	DefaultLimit = 20
-	MaxLimit     = 200
+	GroupByNone = GroupBy("")
+	GroupByDate = GroupBy("date")
 )
 
 //go:generate mockgen -destination=internal/usecase/worker/available_shifts_mock.go -package=worker -source=internal/usecase/worker/available_shifts.go AvailableShifts
@@ -33,59 +43,168 @@ func NewAvailableShifts(wr domain.WorkerRepository) AvailableShifts {
 }
 
 type GetAvailableShiftsRequest struct {
-	Cursor   *cursor.Cursor
-	End      civil.Date
-	Limit    int
-	Start    civil.Date
-	WorkerID int64
+	Cursor        *cursor.Cursor
+	End           civil.Date
+	FacilityIDs   []int64
+	GroupBy       GroupBy
+	Limit         int
+	MaxDuration   time.Duration
+	MinDuration   time.Duration
+	Sort          domain.ShiftSort
+	Start         civil.Date
+	StartTimeFrom *civil.Time
+	StartTimeTo   *civil.Time
+	WorkerID      int64
 }
 
+// GetAvailableShiftsResponse holds a page of shifts in ascending order. The
+// cursors are nil when there are no pages in their direction.
 type GetAvailableShiftsResponse struct {
+	Dates      []DateShifts
 	NextCursor *cursor.Cursor
+	PrevCursor *cursor.Cursor
 	Shifts     domain.Shifts
 }
 
-func (as *availableShifts) GetAvailableShifts(ctx context.Context, req GetAvailableShiftsRequest) (*GetAvailableShiftsResponse, error) {
-	if req.Limit <= 0 || req.Limit > MaxLimit {
-		req.Limit = DefaultLimit
-	}
+// DateShifts holds the shifts of a page starting on the same calendar date.
+// Partial is set when shifts from that date may also be found on other pages.
+type DateShifts struct {
+	Date    civil.Date
+	Partial bool
+	Shifts  domain.Shifts
+}
 
+func (as *availableShifts) GetAvailableShifts(ctx context.Context, req GetAvailableShiftsRequest) (*GetAvailableShiftsResponse, error) {
 	if req.WorkerID == 0 {
 		return nil, errors.New("a worker_id is required to get available shifts from a worker")
 	}
 
 	if !req.Start.IsZero() && req.End.IsZero() {
-		return nil, errors.New("end is required when start is provided")
+		return nil, fmt.Errorf("%w: end is required when start is provided", domain.ErrInvalidShiftFilter)
 	}
 
 	if req.Start.IsZero() && !req.End.IsZero() {
-		return nil, errors.New("start is required when end is provided")
+		return nil, fmt.Errorf("%w: start is required when end is provided", domain.ErrInvalidShiftFilter)
+	}
+
+	if req.MinDuration < 0 || req.MaxDuration < 0 {
+		return nil, fmt.Errorf("%w: durations must not be negative", domain.ErrInvalidShiftFilter)
+	}
+
+	if req.MaxDuration > 0 && req.MinDuration > req.MaxDuration {
+		return nil, fmt.Errorf("%w: min_duration must not be greater than max_duration", domain.ErrInvalidShiftFilter)
+	}
+
+	if (req.StartTimeFrom != nil && !req.StartTimeFrom.IsValid()) || (req.StartTimeTo != nil && !req.StartTimeTo.IsValid()) {
+		return nil, fmt.Errorf("%w: start times must be valid times of day", domain.ErrInvalidShiftFilter)
+	}
+
+	if req.GroupBy != GroupByNone && req.GroupBy != GroupByDate {
+		return nil, errors.New("group_by must be empty or date")
 	}
 
-	shifts, err := as.workerRepository.GetAvailableShifts(
-		ctx,
+	switch req.Sort {
+	case "":
+		req.Sort = domain.ShiftSortStart
+	case domain.ShiftSortEnd, domain.ShiftSortFacility, domain.ShiftSortStart:
+	default:
+		return nil, errors.New("sort must be start, end or facility")
+	}
+
+	page, err := cursor.Paginate(
 		req.Cursor,
 		req.Limit,
-		req.WorkerID,
-		req.Start,
-		req.End,
+		func(limit int) ([]domain.Shift, error) {
+			return as.workerRepository.GetAvailableShifts(
+				ctx,
+				req.Cursor,
+				limit,
+				req.WorkerID,
+				domain.ShiftFilter{
+					End:           req.End,
+					FacilityIDs:   req.FacilityIDs,
+					MaxDuration:   req.MaxDuration,
+					MinDuration:   req.MinDuration,
+					Start:         req.Start,
+					StartTimeFrom: req.StartTimeFrom,
+					StartTimeTo:   req.StartTimeTo,
+				},
+				req.Sort,
+			)
+		},
+		req.Sort.CursorKeys,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	shifts := domain.Shifts(page.Rows)
 	if len(shifts) == 0 {
 		return &GetAvailableShiftsResponse{}, nil
 	}
 
-	sort.Slice(shifts, func(i, j int) bool {
-		return shifts[i].ID < shifts[j].ID
+	resp := &GetAvailableShiftsResponse{
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		Shifts:     shifts,
+	}
+
+	if req.GroupBy == GroupByDate {
+		resp.Dates = groupByDate(shifts)
+
+		if req.Sort == domain.ShiftSortStart {
+			// Pages are chronological, so previous pages only have shifts
+			// starting up to the first one of this page, and next pages from
+			// the last one on. Dates are in each facility's time zone, so any
+			// date these instants fall on in some time zone may be partial.
+			firstStart, lastStart := shifts[0].Start, shifts[len(shifts)-1].Start
+			for i := range resp.Dates {
+				d := &resp.Dates[i]
+				d.Partial = (resp.PrevCursor != nil && !d.Date.After(civil.DateOf(firstStart.In(easternmostZone)))) ||
+					(resp.NextCursor != nil && !d.Date.Before(civil.DateOf(lastStart.In(westernmostZone))))
+			}
+		} else {
+			// Pages are not chronological, so whenever there are other pages
+			// any date may have shifts on them as well.
+			for i := range resp.Dates {
+				resp.Dates[i].Partial = resp.NextCursor != nil || resp.PrevCursor != nil
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// groupByDate groups shifts by the date they start on in their facility's time
+// zone.
+func groupByDate(shifts domain.Shifts) []DateShifts {
+	sorted := make(domain.Shifts, len(shifts))
+	copy(sorted, shifts)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if di, dj := civil.DateOf(sorted[i].Start), civil.DateOf(sorted[j].Start); di != dj {
+			return di.Before(dj)
+		}
+
+		if !sorted[i].Start.Equal(sorted[j].Start) {
+			return sorted[i].Start.Before(sorted[j].Start)
+		}
+
+		return sorted[i].ID < sorted[j].ID
 	})
 
-	nextCursor := cursor.New(cursor.DirectionAfter, strconv.FormatInt(shifts[len(shifts)-1].ID, 10))
+	dates := []DateShifts{}
+	for _, s := range sorted {
+		date := civil.DateOf(s.Start)
 
-	return &GetAvailableShiftsResponse{
-		NextCursor: nextCursor,
-		Shifts:     shifts,
-	}, nil
+		if len(dates) == 0 || dates[len(dates)-1].Date != date {
+			dates = append(dates, DateShifts{
+				Date: date,
+			})
+		}
+
+		dates[len(dates)-1].Shifts = append(dates[len(dates)-1].Shifts, s)
+	}
+
+	return dates
 }
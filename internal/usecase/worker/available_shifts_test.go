@@ -19,7 +19,7 @@ func TestGetAvailableShifts(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	fakeCursor := cursor.Cursor{}
+	fakeCursor := cursor.Cursor{Direction: cursor.DirectionAfter, Keys: []string{"2023-06-04T05:00:00Z", "100"}}
 	fakeLimit := 10
 	fakeWorkerID := int64(123123)
 	fakeStartDate := civil.Date{Year: 2023, Month: 06, Day: 04}
@@ -85,6 +85,86 @@ func TestGetAvailableShifts(t *testing.T) {
 		assert.Nil(t, availableShifts)
 	})
 
+	t.Run("should return error when given a min duration greater than the max duration", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewAvailableShifts(nil)
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				MaxDuration: 4 * time.Hour,
+				MinDuration: 8 * time.Hour,
+				WorkerID:    fakeWorkerID,
+			},
+		)
+		assert.ErrorIs(t, err, domain.ErrInvalidShiftFilter)
+		assert.Nil(t, availableShifts)
+	})
+
+	t.Run("should return error when given a negative duration", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewAvailableShifts(nil)
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				MinDuration: -time.Hour,
+				WorkerID:    fakeWorkerID,
+			},
+		)
+		assert.ErrorIs(t, err, domain.ErrInvalidShiftFilter)
+		assert.Nil(t, availableShifts)
+	})
+
+	t.Run("should return error when given an invalid start time", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewAvailableShifts(nil)
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				StartTimeFrom: &civil.Time{Hour: 25},
+				WorkerID:      fakeWorkerID,
+			},
+		)
+		assert.ErrorIs(t, err, domain.ErrInvalidShiftFilter)
+		assert.Nil(t, availableShifts)
+	})
+
+	t.Run("should return error when given an unknown sort", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewAvailableShifts(nil)
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				End:      fakeEndDate,
+				Sort:     domain.ShiftSort("profession"),
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			},
+		)
+		assert.Error(t, err)
+		assert.Nil(t, availableShifts)
+	})
+
+	t.Run("should return error when given an unknown group by", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewAvailableShifts(nil)
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				End:      fakeEndDate,
+				GroupBy:  worker.GroupBy("facility"),
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			},
+		)
+		assert.Error(t, err)
+		assert.Nil(t, availableShifts)
+	})
+
 	t.Run("should return error if fails to get available shifts", func(t *testing.T) {
 		t.Parallel()
 
@@ -94,7 +174,7 @@ func TestGetAvailableShifts(t *testing.T) {
 		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
 		mockWorkerRepository.
 			EXPECT().
-			GetAvailableShifts(ctx, &fakeCursor, fakeLimit, fakeWorkerID, fakeStartDate, fakeEndDate).
+			GetAvailableShifts(ctx, &fakeCursor, fakeLimit+1, fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
 			Times(1).
 			Return(nil, errors.New("fake error"))
 
@@ -122,7 +202,7 @@ func TestGetAvailableShifts(t *testing.T) {
 		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
 		mockWorkerRepository.
 			EXPECT().
-			GetAvailableShifts(ctx, &fakeCursor, fakeLimit, fakeWorkerID, fakeStartDate, fakeEndDate).
+			GetAvailableShifts(ctx, &fakeCursor, fakeLimit+1, fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
 			Times(1).
 			Return(fakeShifts, nil)
 
@@ -140,7 +220,7 @@ func TestGetAvailableShifts(t *testing.T) {
 		)
 		assert.NoError(t, err)
 		assert.Equal(t, &worker.GetAvailableShiftsResponse{
-			NextCursor: cursor.New(cursor.DirectionAfter, "321"),
+			PrevCursor: cursor.New(cursor.DirectionBefore, domain.ShiftSortStart.CursorKeys(fakeShifts[0])...),
 			Shifts:     fakeShifts,
 		}, availableShifts)
 	})
@@ -154,7 +234,7 @@ func TestGetAvailableShifts(t *testing.T) {
 		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
 		mockWorkerRepository.
 			EXPECT().
-			GetAvailableShifts(ctx, &fakeCursor, worker.DefaultLimit, fakeWorkerID, fakeStartDate, fakeEndDate).
+			GetAvailableShifts(ctx, &fakeCursor, cursor.DefaultLimit+1, fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
 			Times(1).
 			Return(fakeShifts, nil)
 
@@ -171,7 +251,7 @@ func TestGetAvailableShifts(t *testing.T) {
 		)
 		assert.NoError(t, err)
 		assert.Equal(t, &worker.GetAvailableShiftsResponse{
-			NextCursor: cursor.New(cursor.DirectionAfter, "321"),
+			PrevCursor: cursor.New(cursor.DirectionBefore, domain.ShiftSortStart.CursorKeys(fakeShifts[0])...),
 			Shifts:     fakeShifts,
 		}, availableShifts)
 	})
@@ -185,7 +265,7 @@ func TestGetAvailableShifts(t *testing.T) {
 		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
 		mockWorkerRepository.
 			EXPECT().
-			GetAvailableShifts(ctx, nil, worker.DefaultLimit, fakeWorkerID, fakeStartDate, fakeEndDate).
+			GetAvailableShifts(ctx, nil, cursor.DefaultLimit+1, fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
 			Times(1).
 			Return(fakeShifts, nil)
 
@@ -201,7 +281,95 @@ func TestGetAvailableShifts(t *testing.T) {
 		)
 		assert.NoError(t, err)
 		assert.Equal(t, &worker.GetAvailableShiftsResponse{
-			NextCursor: cursor.New(cursor.DirectionAfter, "321"),
+			Shifts: fakeShifts,
+		}, availableShifts)
+	})
+
+	t.Run("should return a next cursor and drop the extra shift when there are more pages", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, &fakeCursor, len(fakeShifts), fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
+			Times(1).
+			Return(fakeShifts, nil)
+
+		us := worker.NewAvailableShifts(mockWorkerRepository)
+
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				Cursor:   &fakeCursor,
+				End:      fakeEndDate,
+				Limit:    len(fakeShifts) - 1,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, &worker.GetAvailableShiftsResponse{
+			NextCursor: cursor.New(cursor.DirectionAfter, domain.ShiftSortStart.CursorKeys(fakeShifts[0])...),
+			PrevCursor: cursor.New(cursor.DirectionBefore, domain.ShiftSortStart.CursorKeys(fakeShifts[0])...),
+			Shifts:     fakeShifts[:1],
+		}, availableShifts)
+	})
+
+	t.Run("should drop the first shift and always return a next cursor when going backwards", func(t *testing.T) {
+		t.Parallel()
+
+		beforeCursor := cursor.Cursor{Direction: cursor.DirectionBefore, Keys: []string{"2023-06-10T05:00:00Z", "500"}}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, &beforeCursor, len(fakeShifts), fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
+			Times(1).
+			Return(fakeShifts, nil)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, &beforeCursor, len(fakeShifts)+1, fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
+			Times(1).
+			Return(fakeShifts, nil)
+
+		us := worker.NewAvailableShifts(mockWorkerRepository)
+
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				Cursor:   &beforeCursor,
+				End:      fakeEndDate,
+				Limit:    len(fakeShifts) - 1,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, &worker.GetAvailableShiftsResponse{
+			NextCursor: cursor.New(cursor.DirectionAfter, domain.ShiftSortStart.CursorKeys(fakeShifts[1])...),
+			PrevCursor: cursor.New(cursor.DirectionBefore, domain.ShiftSortStart.CursorKeys(fakeShifts[1])...),
+			Shifts:     fakeShifts[1:],
+		}, availableShifts)
+
+		availableShifts, err = us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				Cursor:   &beforeCursor,
+				End:      fakeEndDate,
+				Limit:    len(fakeShifts),
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, &worker.GetAvailableShiftsResponse{
+			NextCursor: cursor.New(cursor.DirectionAfter, domain.ShiftSortStart.CursorKeys(fakeShifts[1])...),
 			Shifts:     fakeShifts,
 		}, availableShifts)
 	})
@@ -215,7 +383,7 @@ func TestGetAvailableShifts(t *testing.T) {
 		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
 		mockWorkerRepository.
 			EXPECT().
-			GetAvailableShifts(ctx, nil, worker.DefaultLimit, fakeWorkerID, fakeStartDate, fakeEndDate).
+			GetAvailableShifts(ctx, nil, cursor.DefaultLimit+1, fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
 			Times(1).
 			Return(domain.Shifts{}, nil)
 
@@ -242,7 +410,7 @@ func TestGetAvailableShifts(t *testing.T) {
 		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
 		mockWorkerRepository.
 			EXPECT().
-			GetAvailableShifts(ctx, &fakeCursor, worker.DefaultLimit, fakeWorkerID, fakeStartDate, fakeEndDate).
+			GetAvailableShifts(ctx, &fakeCursor, cursor.DefaultLimit+1, fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
 			Times(1).
 			Return(fakeShifts, nil)
 
@@ -260,8 +428,269 @@ func TestGetAvailableShifts(t *testing.T) {
 		)
 		assert.NoError(t, err)
 		assert.Equal(t, &worker.GetAvailableShiftsResponse{
-			NextCursor: cursor.New(cursor.DirectionAfter, "321"),
+			PrevCursor: cursor.New(cursor.DirectionBefore, domain.ShiftSortStart.CursorKeys(fakeShifts[0])...),
 			Shifts:     fakeShifts,
 		}, availableShifts)
 	})
+
+	t.Run("should successfully get available shifts grouped by date", func(t *testing.T) {
+		t.Parallel()
+
+		firstDay := time.Date(2023, time.June, 4, 20, 0, 0, 0, time.UTC)
+		secondDay := time.Date(2023, time.June, 5, 5, 0, 0, 0, time.UTC)
+		shifts := domain.Shifts{
+			{End: secondDay.Add(5 * time.Hour), ID: 1, Start: secondDay},
+			{End: firstDay.Add(5 * time.Hour), ID: 2, Start: firstDay},
+			{End: secondDay.Add(13 * time.Hour), ID: 3, Start: secondDay.Add(8 * time.Hour)},
+		}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, fakeLimit+1, fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
+			Times(1).
+			Return(shifts, nil)
+
+		us := worker.NewAvailableShifts(mockWorkerRepository)
+
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				End:      fakeEndDate,
+				GroupBy:  worker.GroupByDate,
+				Limit:    fakeLimit,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, []worker.DateShifts{
+			{
+				Date:   civil.DateOf(firstDay),
+				Shifts: domain.Shifts{shifts[1]},
+			},
+			{
+				Date:   civil.DateOf(secondDay),
+				Shifts: domain.Shifts{shifts[0], shifts[2]},
+			},
+		}, availableShifts.Dates)
+	})
+
+	t.Run("should flag dates as partial when there may be more pages", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, len(fakeShifts), fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
+			Times(1).
+			Return(fakeShifts, nil)
+
+		us := worker.NewAvailableShifts(mockWorkerRepository)
+
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				End:      fakeEndDate,
+				GroupBy:  worker.GroupByDate,
+				Limit:    len(fakeShifts) - 1,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Len(t, availableShifts.Dates, 1)
+		assert.True(t, availableShifts.Dates[0].Partial)
+	})
+
+	t.Run("should build cursors from the keys of the given sort", func(t *testing.T) {
+		t.Parallel()
+
+		facilityCursor := cursor.Cursor{Direction: cursor.DirectionAfter, Keys: []string{"Facility A", "2023-06-04T05:00:00Z", "100"}}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, &facilityCursor, len(fakeShifts), fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortFacility).
+			Times(1).
+			Return(fakeShifts, nil)
+
+		us := worker.NewAvailableShifts(mockWorkerRepository)
+
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				Cursor:   &facilityCursor,
+				End:      fakeEndDate,
+				Limit:    len(fakeShifts) - 1,
+				Sort:     domain.ShiftSortFacility,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, &worker.GetAvailableShiftsResponse{
+			NextCursor: cursor.New(cursor.DirectionAfter, domain.ShiftSortFacility.CursorKeys(fakeShifts[0])...),
+			PrevCursor: cursor.New(cursor.DirectionBefore, domain.ShiftSortFacility.CursorKeys(fakeShifts[0])...),
+			Shifts:     fakeShifts[:1],
+		}, availableShifts)
+	})
+
+	t.Run("should only flag the dates near the edges of a page as partial when sorted by start", func(t *testing.T) {
+		t.Parallel()
+
+		firstDay := time.Date(2023, time.June, 4, 10, 0, 0, 0, time.UTC)
+		shifts := domain.Shifts{
+			{End: firstDay.Add(5 * time.Hour), ID: 1, Start: firstDay},
+			{End: firstDay.Add(53 * time.Hour), ID: 2, Start: firstDay.Add(48 * time.Hour)},
+			{End: firstDay.Add(101 * time.Hour), ID: 3, Start: firstDay.Add(96 * time.Hour)},
+			{End: firstDay.Add(149 * time.Hour), ID: 4, Start: firstDay.Add(144 * time.Hour)},
+		}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, &fakeCursor, len(shifts), fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
+			Times(1).
+			Return(shifts, nil)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, &fakeCursor, len(shifts), fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortEnd).
+			Times(1).
+			Return(shifts, nil)
+
+		us := worker.NewAvailableShifts(mockWorkerRepository)
+
+		for sort, partial := range map[domain.ShiftSort][]bool{
+			domain.ShiftSortStart: {true, false, true},
+			domain.ShiftSortEnd:   {true, true, true},
+		} {
+			availableShifts, err := us.GetAvailableShifts(
+				ctx,
+				worker.GetAvailableShiftsRequest{
+					Cursor:   &fakeCursor,
+					End:      fakeEndDate,
+					GroupBy:  worker.GroupByDate,
+					Limit:    len(shifts) - 1,
+					Sort:     sort,
+					Start:    fakeStartDate,
+					WorkerID: fakeWorkerID,
+				},
+			)
+			assert.NoError(t, err)
+
+			var gotPartial []bool
+			for _, d := range availableShifts.Dates {
+				gotPartial = append(gotPartial, d.Partial)
+			}
+
+			assert.Equal(t, partial, gotPartial, "sort %q", sort)
+		}
+	})
+
+	t.Run("should group shifts by the date they start on in their facility's time zone", func(t *testing.T) {
+		t.Parallel()
+
+		newYork, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// 2023-06-05 02:00 UTC is still June 4th in New York.
+		shifts := domain.Shifts{
+			{ID: 1, Start: time.Date(2023, time.June, 5, 2, 0, 0, 0, time.UTC)},
+			{ID: 2, Start: time.Date(2023, time.June, 5, 2, 0, 0, 0, time.UTC).In(newYork)},
+			{ID: 3, Start: time.Date(2023, time.June, 5, 3, 0, 0, 0, time.UTC)},
+		}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, fakeLimit+1, fakeWorkerID, domain.ShiftFilter{End: fakeEndDate, Start: fakeStartDate}, domain.ShiftSortStart).
+			Times(1).
+			Return(shifts, nil)
+
+		us := worker.NewAvailableShifts(mockWorkerRepository)
+
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				End:      fakeEndDate,
+				GroupBy:  worker.GroupByDate,
+				Limit:    fakeLimit,
+				Start:    fakeStartDate,
+				WorkerID: fakeWorkerID,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, []worker.DateShifts{
+			{
+				Date:   civil.Date{Year: 2023, Month: time.June, Day: 4},
+				Shifts: domain.Shifts{shifts[1]},
+			},
+			{
+				Date:   civil.Date{Year: 2023, Month: time.June, Day: 5},
+				Shifts: domain.Shifts{shifts[0], shifts[2]},
+			},
+		}, availableShifts.Dates)
+	})
+
+	t.Run("should filter available shifts by the given filters", func(t *testing.T) {
+		t.Parallel()
+
+		startTimeFrom := civil.Time{Hour: 20}
+		startTimeTo := civil.Time{Hour: 4}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetAvailableShifts(ctx, nil, fakeLimit+1, fakeWorkerID, domain.ShiftFilter{
+				End:           fakeEndDate,
+				FacilityIDs:   []int64{1, 2},
+				MaxDuration:   8 * time.Hour,
+				MinDuration:   4 * time.Hour,
+				Start:         fakeStartDate,
+				StartTimeFrom: &startTimeFrom,
+				StartTimeTo:   &startTimeTo,
+			}, domain.ShiftSortStart).
+			Times(1).
+			Return(fakeShifts, nil)
+
+		us := worker.NewAvailableShifts(mockWorkerRepository)
+
+		availableShifts, err := us.GetAvailableShifts(
+			ctx,
+			worker.GetAvailableShiftsRequest{
+				End:           fakeEndDate,
+				FacilityIDs:   []int64{1, 2},
+				Limit:         fakeLimit,
+				MaxDuration:   8 * time.Hour,
+				MinDuration:   4 * time.Hour,
+				Start:         fakeStartDate,
+				StartTimeFrom: &startTimeFrom,
+				StartTimeTo:   &startTimeTo,
+				WorkerID:      fakeWorkerID,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, fakeShifts, availableShifts.Shifts)
+	})
 }
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/worker/cancel_shift_mock.go -package=worker -source=internal/usecase/worker/cancel_shift.go CancelShift
+type CancelShift interface {
+	CancelShift(ctx context.Context, req CancelShiftRequest) (*CancelShiftResponse, error)
+}
+
+type cancelShift struct {
+	cutoff           time.Duration
+	workerRepository domain.WorkerRepository
+}
+
+// NewCancelShift builds a CancelShift use case which refuses to cancel shifts
+// starting within the given cutoff.
+func NewCancelShift(wr domain.WorkerRepository, cutoff time.Duration) CancelShift {
+	return &cancelShift{
+		cutoff:           cutoff,
+		workerRepository: wr,
+	}
+}
+
+type CancelShiftRequest struct {
+	ShiftID  int64
+	WorkerID int64
+}
+
+type CancelShiftResponse struct {
+	Shift domain.Shift
+}
+
+func (cs *cancelShift) CancelShift(ctx context.Context, req CancelShiftRequest) (*CancelShiftResponse, error) {
+	if req.WorkerID == 0 {
+		return nil, errors.New("a worker_id is required to cancel a shift")
+	}
+
+	if req.ShiftID == 0 {
+		return nil, errors.New("a shift_id is required to cancel a shift")
+	}
+
+	shift, err := cs.workerRepository.CancelShift(ctx, req.WorkerID, req.ShiftID, time.Now().Add(cs.cutoff))
+	if err != nil {
+		return nil, err
+	}
+
+	return &CancelShiftResponse{
+		Shift: *shift,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/worker/cancel_shift.go
+
+// Package worker is a generated GoMock package.
+package worker
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCancelShift is a mock of CancelShift interface.
+type MockCancelShift struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelShiftMockRecorder
+}
+
+// MockCancelShiftMockRecorder is the mock recorder for MockCancelShift.
+type MockCancelShiftMockRecorder struct {
+	mock *MockCancelShift
+}
+
+// NewMockCancelShift creates a new mock instance.
+func NewMockCancelShift(ctrl *gomock.Controller) *MockCancelShift {
+	mock := &MockCancelShift{ctrl: ctrl}
+	mock.recorder = &MockCancelShiftMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelShift) EXPECT() *MockCancelShiftMockRecorder {
+	return m.recorder
+}
+
+// CancelShift mocks base method.
+func (m *MockCancelShift) CancelShift(ctx context.Context, req CancelShiftRequest) (*CancelShiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelShift", ctx, req)
+	ret0, _ := ret[0].(*CancelShiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelShift indicates an expected call of CancelShift.
+func (mr *MockCancelShiftMockRecorder) CancelShift(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelShift", reflect.TypeOf((*MockCancelShift)(nil).CancelShift), ctx, req)
+}
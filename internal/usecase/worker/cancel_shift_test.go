@@ -0,0 +1,100 @@
+package worker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/worker"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancelShift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeCutoff := 24 * time.Hour
+	fakeShiftID := int64(321)
+	fakeWorkerID := int64(123123)
+	fakeShift := domain.Shift{
+		End:   time.Time{},
+		ID:    fakeShiftID,
+		Start: time.Time{},
+	}
+
+	t.Run("should return error when no worker is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewCancelShift(nil, fakeCutoff)
+		cancelledShift, err := us.CancelShift(ctx, worker.CancelShiftRequest{
+			ShiftID: fakeShiftID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, cancelledShift)
+	})
+
+	t.Run("should return error when no shift is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewCancelShift(nil, fakeCutoff)
+		cancelledShift, err := us.CancelShift(ctx, worker.CancelShiftRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, cancelledShift)
+	})
+
+	t.Run("should only cancel shifts starting after the cutoff", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		earliest := time.Now().Add(fakeCutoff)
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			CancelShift(ctx, fakeWorkerID, fakeShiftID, gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, _ int64, _ int64, startsAfter time.Time) (*domain.Shift, error) {
+				assert.False(t, startsAfter.Before(earliest))
+				assert.WithinDuration(t, earliest, startsAfter, time.Minute)
+
+				return nil, domain.ErrCancellationCutoff
+			})
+
+		us := worker.NewCancelShift(mockWorkerRepository, fakeCutoff)
+		cancelledShift, err := us.CancelShift(ctx, worker.CancelShiftRequest{
+			ShiftID:  fakeShiftID,
+			WorkerID: fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrCancellationCutoff)
+		assert.Nil(t, cancelledShift)
+	})
+
+	t.Run("should successfully cancel the shift", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			CancelShift(ctx, fakeWorkerID, fakeShiftID, gomock.Any()).
+			Times(1).
+			Return(&fakeShift, nil)
+
+		us := worker.NewCancelShift(mockWorkerRepository, fakeCutoff)
+		cancelledShift, err := us.CancelShift(ctx, worker.CancelShiftRequest{
+			ShiftID:  fakeShiftID,
+			WorkerID: fakeWorkerID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &worker.CancelShiftResponse{Shift: fakeShift}, cancelledShift)
+	})
+}
@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/worker/claim_shift_mock.go -package=worker -source=internal/usecase/worker/claim_shift.go ClaimShift
+type ClaimShift interface {
+	ClaimShift(ctx context.Context, req ClaimShiftRequest) (*ClaimShiftResponse, error)
+}
+
+type claimShift struct {
+	workerRepository domain.WorkerRepository
+}
+
+func NewClaimShift(wr domain.WorkerRepository) ClaimShift {
+	return &claimShift{
+		workerRepository: wr,
+	}
+}
+
+type ClaimShiftRequest struct {
+	ShiftID  int64
+	WorkerID int64
+}
+
+type ClaimShiftResponse struct {
+	Shift domain.Shift
+}
+
+func (cs *claimShift) ClaimShift(ctx context.Context, req ClaimShiftRequest) (*ClaimShiftResponse, error) {
+	if req.WorkerID == 0 {
+		return nil, errors.New("a worker_id is required to claim a shift")
+	}
+
+	if req.ShiftID == 0 {
+		return nil, errors.New("a shift_id is required to claim a shift")
+	}
+
+	shift, err := cs.workerRepository.ClaimShift(ctx, req.WorkerID, req.ShiftID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClaimShiftResponse{
+		Shift: *shift,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/worker/claim_shift.go
+
+// Package worker is a generated GoMock package.
+package worker
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClaimShift is a mock of ClaimShift interface.
+type MockClaimShift struct {
+	ctrl     *gomock.Controller
+	recorder *MockClaimShiftMockRecorder
+}
+
+// MockClaimShiftMockRecorder is the mock recorder for MockClaimShift.
+type MockClaimShiftMockRecorder struct {
+	mock *MockClaimShift
+}
+
+// NewMockClaimShift creates a new mock instance.
+func NewMockClaimShift(ctrl *gomock.Controller) *MockClaimShift {
+	mock := &MockClaimShift{ctrl: ctrl}
+	mock.recorder = &MockClaimShiftMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClaimShift) EXPECT() *MockClaimShiftMockRecorder {
+	return m.recorder
+}
+
+// ClaimShift mocks base method.
+func (m *MockClaimShift) ClaimShift(ctx context.Context, req ClaimShiftRequest) (*ClaimShiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimShift", ctx, req)
+	ret0, _ := ret[0].(*ClaimShiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimShift indicates an expected call of ClaimShift.
+func (mr *MockClaimShiftMockRecorder) ClaimShift(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimShift", reflect.TypeOf((*MockClaimShift)(nil).ClaimShift), ctx, req)
+}
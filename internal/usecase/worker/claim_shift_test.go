@@ -0,0 +1,92 @@
+package worker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/worker"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimShift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeShiftID := int64(321)
+	fakeWorkerID := int64(123123)
+	fakeShift := domain.Shift{
+		End:   time.Time{},
+		ID:    fakeShiftID,
+		Start: time.Time{},
+	}
+
+	t.Run("should return error when no worker is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewClaimShift(nil)
+		claimedShift, err := us.ClaimShift(ctx, worker.ClaimShiftRequest{
+			ShiftID: fakeShiftID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, claimedShift)
+	})
+
+	t.Run("should return error when no shift is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewClaimShift(nil)
+		claimedShift, err := us.ClaimShift(ctx, worker.ClaimShiftRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, claimedShift)
+	})
+
+	t.Run("should return error if the shift is already claimed", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			ClaimShift(ctx, fakeWorkerID, fakeShiftID).
+			Times(1).
+			Return(nil, domain.ErrShiftAlreadyClaimed)
+
+		us := worker.NewClaimShift(mockWorkerRepository)
+		claimedShift, err := us.ClaimShift(ctx, worker.ClaimShiftRequest{
+			ShiftID:  fakeShiftID,
+			WorkerID: fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrShiftAlreadyClaimed)
+		assert.Nil(t, claimedShift)
+	})
+
+	t.Run("should successfully claim the shift", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			ClaimShift(ctx, fakeWorkerID, fakeShiftID).
+			Times(1).
+			Return(&fakeShift, nil)
+
+		us := worker.NewClaimShift(mockWorkerRepository)
+		claimedShift, err := us.ClaimShift(ctx, worker.ClaimShiftRequest{
+			ShiftID:  fakeShiftID,
+			WorkerID: fakeWorkerID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &worker.ClaimShiftResponse{Shift: fakeShift}, claimedShift)
+	})
+}
@@ -0,0 +1,46 @@
+package worker
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/worker/get_worker_mock.go -package=worker -source=internal/usecase/worker/get_worker.go GetWorker
+type GetWorker interface {
+	GetWorker(ctx context.Context, req GetWorkerRequest) (*GetWorkerResponse, error)
+}
+
+type getWorker struct {
+	workerRepository domain.WorkerRepository
+}
+
+func NewGetWorker(wr domain.WorkerRepository) GetWorker {
+	return &getWorker{
+		workerRepository: wr,
+	}
+}
+
+type GetWorkerRequest struct {
+	WorkerID int64
+}
+
+type GetWorkerResponse struct {
+	Worker domain.Worker
+}
+
+func (gw *getWorker) GetWorker(ctx context.Context, req GetWorkerRequest) (*GetWorkerResponse, error) {
+	if req.WorkerID == 0 {
+		return nil, errors.New("a worker_id is required to get a worker")
+	}
+
+	worker, err := gw.workerRepository.GetWorker(ctx, req.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetWorkerResponse{
+		Worker: *worker,
+	}, nil
+}
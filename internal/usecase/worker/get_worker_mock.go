@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/worker/get_worker.go
+
+// Package worker is a generated GoMock package.
+package worker
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetWorker is a mock of GetWorker interface.
+type MockGetWorker struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetWorkerMockRecorder
+}
+
+// MockGetWorkerMockRecorder is the mock recorder for MockGetWorker.
+type MockGetWorkerMockRecorder struct {
+	mock *MockGetWorker
+}
+
+// NewMockGetWorker creates a new mock instance.
+func NewMockGetWorker(ctrl *gomock.Controller) *MockGetWorker {
+	mock := &MockGetWorker{ctrl: ctrl}
+	mock.recorder = &MockGetWorkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetWorker) EXPECT() *MockGetWorkerMockRecorder {
+	return m.recorder
+}
+
+// GetWorker mocks base method.
+func (m *MockGetWorker) GetWorker(ctx context.Context, req GetWorkerRequest) (*GetWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorker", ctx, req)
+	ret0, _ := ret[0].(*GetWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorker indicates an expected call of GetWorker.
+func (mr *MockGetWorkerMockRecorder) GetWorker(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorker", reflect.TypeOf((*MockGetWorker)(nil).GetWorker), ctx, req)
+}
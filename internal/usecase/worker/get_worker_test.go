@@ -0,0 +1,76 @@
+package worker_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/worker"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWorker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeWorkerID := int64(123123)
+	fakeWorker := domain.Worker{
+		ID:         fakeWorkerID,
+		IsActive:   true,
+		Name:       "worker",
+		Profession: domain.ProfessionCNA,
+	}
+
+	t.Run("should return error when no worker is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewGetWorker(nil)
+		resp, err := us.GetWorker(ctx, worker.GetWorkerRequest{})
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should return error when the worker does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetWorker(ctx, fakeWorkerID).
+			Times(1).
+			Return(nil, domain.ErrWorkerNotFound)
+
+		us := worker.NewGetWorker(mockWorkerRepository)
+		resp, err := us.GetWorker(ctx, worker.GetWorkerRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrWorkerNotFound)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should successfully return the worker", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetWorker(ctx, fakeWorkerID).
+			Times(1).
+			Return(&fakeWorker, nil)
+
+		us := worker.NewGetWorker(mockWorkerRepository)
+		resp, err := us.GetWorker(ctx, worker.GetWorkerRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &worker.GetWorkerResponse{Worker: fakeWorker}, resp)
+	})
+}
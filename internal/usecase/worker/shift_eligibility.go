@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/worker/shift_eligibility_mock.go -package=worker -source=internal/usecase/worker/shift_eligibility.go ShiftEligibility
+type ShiftEligibility interface {
+	GetShiftEligibility(ctx context.Context, req GetShiftEligibilityRequest) (*GetShiftEligibilityResponse, error)
+}
+
+type shiftEligibility struct {
+	workerRepository domain.WorkerRepository
+}
+
+func NewShiftEligibility(wr domain.WorkerRepository) ShiftEligibility {
+	return &shiftEligibility{
+		workerRepository: wr,
+	}
+}
+
+type GetShiftEligibilityRequest struct {
+	ShiftID  int64
+	WorkerID int64
+}
+
+type GetShiftEligibilityResponse struct {
+	Eligibility domain.Eligibility
+}
+
+func (se *shiftEligibility) GetShiftEligibility(ctx context.Context, req GetShiftEligibilityRequest) (*GetShiftEligibilityResponse, error) {
+	if req.WorkerID == 0 {
+		return nil, errors.New("a worker_id is required to get the eligibility for a shift")
+	}
+
+	if req.ShiftID == 0 {
+		return nil, errors.New("a shift_id is required to get the eligibility for a shift")
+	}
+
+	eligibility, err := se.workerRepository.GetShiftEligibility(ctx, req.WorkerID, req.ShiftID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetShiftEligibilityResponse{
+		Eligibility: *eligibility,
+	}, nil
+}
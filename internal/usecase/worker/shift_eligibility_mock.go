@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/worker/shift_eligibility.go
+
+// Package worker is a generated GoMock package.
+package worker
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShiftEligibility is a mock of ShiftEligibility interface.
+type MockShiftEligibility struct {
+	ctrl     *gomock.Controller
+	recorder *MockShiftEligibilityMockRecorder
+}
+
+// MockShiftEligibilityMockRecorder is the mock recorder for MockShiftEligibility.
+type MockShiftEligibilityMockRecorder struct {
+	mock *MockShiftEligibility
+}
+
+// NewMockShiftEligibility creates a new mock instance.
+func NewMockShiftEligibility(ctrl *gomock.Controller) *MockShiftEligibility {
+	mock := &MockShiftEligibility{ctrl: ctrl}
+	mock.recorder = &MockShiftEligibilityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShiftEligibility) EXPECT() *MockShiftEligibilityMockRecorder {
+	return m.recorder
+}
+
+// GetShiftEligibility mocks base method.
+func (m *MockShiftEligibility) GetShiftEligibility(ctx context.Context, req GetShiftEligibilityRequest) (*GetShiftEligibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShiftEligibility", ctx, req)
+	ret0, _ := ret[0].(*GetShiftEligibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShiftEligibility indicates an expected call of GetShiftEligibility.
+func (mr *MockShiftEligibilityMockRecorder) GetShiftEligibility(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShiftEligibility", reflect.TypeOf((*MockShiftEligibility)(nil).GetShiftEligibility), ctx, req)
+}
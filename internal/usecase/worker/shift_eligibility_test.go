@@ -0,0 +1,96 @@
+package worker_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/worker"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetShiftEligibility(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeShiftID := int64(321)
+	fakeWorkerID := int64(123123)
+	fakeEligibility := domain.Eligibility{
+		Checks: []domain.EligibilityCheck{
+			{Passed: true, Rule: domain.EligibilityRuleFacilityActive},
+			{Passed: false, Rule: domain.EligibilityRuleDocumentsHeld},
+		},
+		MissingDocumentIDs: []int64{4},
+		ShiftID:            fakeShiftID,
+		WorkerID:           fakeWorkerID,
+	}
+
+	t.Run("should return error when no worker is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewShiftEligibility(nil)
+		eligibility, err := us.GetShiftEligibility(ctx, worker.GetShiftEligibilityRequest{
+			ShiftID: fakeShiftID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, eligibility)
+	})
+
+	t.Run("should return error when no shift is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewShiftEligibility(nil)
+		eligibility, err := us.GetShiftEligibility(ctx, worker.GetShiftEligibilityRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, eligibility)
+	})
+
+	t.Run("should return error if the worker does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetShiftEligibility(ctx, fakeWorkerID, fakeShiftID).
+			Times(1).
+			Return(nil, domain.ErrWorkerNotFound)
+
+		us := worker.NewShiftEligibility(mockWorkerRepository)
+		eligibility, err := us.GetShiftEligibility(ctx, worker.GetShiftEligibilityRequest{
+			ShiftID:  fakeShiftID,
+			WorkerID: fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrWorkerNotFound)
+		assert.Nil(t, eligibility)
+	})
+
+	t.Run("should successfully get the shift eligibility", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			GetShiftEligibility(ctx, fakeWorkerID, fakeShiftID).
+			Times(1).
+			Return(&fakeEligibility, nil)
+
+		us := worker.NewShiftEligibility(mockWorkerRepository)
+		eligibility, err := us.GetShiftEligibility(ctx, worker.GetShiftEligibilityRequest{
+			ShiftID:  fakeShiftID,
+			WorkerID: fakeWorkerID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &worker.GetShiftEligibilityResponse{Eligibility: fakeEligibility}, eligibility)
+		assert.False(t, eligibility.Eligibility.Eligible())
+	})
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+)
+
+//go:generate mockgen -destination=internal/usecase/worker/update_worker_mock.go -package=worker -source=internal/usecase/worker/update_worker.go UpdateWorker
+type UpdateWorker interface {
+	UpdateWorker(ctx context.Context, req UpdateWorkerRequest) (*UpdateWorkerResponse, error)
+}
+
+type updateWorker struct {
+	workerRepository domain.WorkerRepository
+}
+
+func NewUpdateWorker(wr domain.WorkerRepository) UpdateWorker {
+	return &updateWorker{
+		workerRepository: wr,
+	}
+}
+
+// UpdateWorkerRequest asks for a worker to be activated, deactivated or to
+// change profession. Deactivating a worker releases the shifts they claimed
+// which have not started yet.
+type UpdateWorkerRequest struct {
+	Update   domain.WorkerUpdate
+	WorkerID int64
+}
+
+type UpdateWorkerResponse struct {
+	ReleasedShifts []domain.Shift
+	Worker         domain.Worker
+}
+
+func (uw *updateWorker) UpdateWorker(ctx context.Context, req UpdateWorkerRequest) (*UpdateWorkerResponse, error) {
+	if req.WorkerID == 0 {
+		return nil, errors.New("a worker_id is required to update a worker")
+	}
+
+	update := req.Update
+
+	if update.IsActive == nil && update.Profession == nil {
+		return nil, fmt.Errorf("%w: nothing to update", domain.ErrInvalidWorker)
+	}
+
+	if update.Profession != nil && !update.Profession.Valid() {
+		return nil, fmt.Errorf("%w: unknown profession %q", domain.ErrInvalidWorker, *update.Profession)
+	}
+
+	change, err := uw.workerRepository.UpdateWorker(ctx, req.WorkerID, update, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateWorkerResponse{
+		ReleasedShifts: change.ReleasedShifts,
+		Worker:         change.Worker,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/worker/update_worker.go
+
+// Package worker is a generated GoMock package.
+package worker
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUpdateWorker is a mock of UpdateWorker interface.
+type MockUpdateWorker struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateWorkerMockRecorder
+}
+
+// MockUpdateWorkerMockRecorder is the mock recorder for MockUpdateWorker.
+type MockUpdateWorkerMockRecorder struct {
+	mock *MockUpdateWorker
+}
+
+// NewMockUpdateWorker creates a new mock instance.
+func NewMockUpdateWorker(ctrl *gomock.Controller) *MockUpdateWorker {
+	mock := &MockUpdateWorker{ctrl: ctrl}
+	mock.recorder = &MockUpdateWorkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateWorker) EXPECT() *MockUpdateWorkerMockRecorder {
+	return m.recorder
+}
+
+// UpdateWorker mocks base method.
+func (m *MockUpdateWorker) UpdateWorker(ctx context.Context, req UpdateWorkerRequest) (*UpdateWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorker", ctx, req)
+	ret0, _ := ret[0].(*UpdateWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorker indicates an expected call of UpdateWorker.
+func (mr *MockUpdateWorkerMockRecorder) UpdateWorker(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorker", reflect.TypeOf((*MockUpdateWorker)(nil).UpdateWorker), ctx, req)
+}
@@ -0,0 +1,96 @@
+package worker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rodrigosdo/facilities-api/internal/domain"
+	"github.com/rodrigosdo/facilities-api/internal/usecase/worker"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateWorker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeWorkerID := int64(123123)
+	inactive := false
+	unknownProfession := domain.Profession("DOCTOR")
+
+	t.Run("should return error when no worker is provided", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewUpdateWorker(nil)
+		resp, err := us.UpdateWorker(ctx, worker.UpdateWorkerRequest{
+			Update: domain.WorkerUpdate{IsActive: &inactive},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should return error when there is nothing to update", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewUpdateWorker(nil)
+		resp, err := us.UpdateWorker(ctx, worker.UpdateWorkerRequest{
+			WorkerID: fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidWorker)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should return error when the profession is unknown", func(t *testing.T) {
+		t.Parallel()
+
+		us := worker.NewUpdateWorker(nil)
+		resp, err := us.UpdateWorker(ctx, worker.UpdateWorkerRequest{
+			Update:   domain.WorkerUpdate{Profession: &unknownProfession},
+			WorkerID: fakeWorkerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidWorker)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should successfully deactivate the worker releasing their future shifts", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		update := domain.WorkerUpdate{IsActive: &inactive}
+		releasedShifts := []domain.Shift{{ID: 321}}
+		fakeWorker := domain.Worker{
+			ID:         fakeWorkerID,
+			Name:       "worker",
+			Profession: domain.ProfessionCNA,
+		}
+
+		mockWorkerRepository := domain.NewMockWorkerRepository(ctrl)
+		mockWorkerRepository.
+			EXPECT().
+			UpdateWorker(ctx, fakeWorkerID, update, gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, _ int64, _ domain.WorkerUpdate, releaseAfter time.Time) (*domain.WorkerChange, error) {
+				assert.WithinDuration(t, time.Now(), releaseAfter, time.Minute)
+
+				return &domain.WorkerChange{
+					ReleasedShifts: releasedShifts,
+					Worker:         fakeWorker,
+				}, nil
+			})
+
+		us := worker.NewUpdateWorker(mockWorkerRepository)
+		resp, err := us.UpdateWorker(ctx, worker.UpdateWorkerRequest{
+			Update:   update,
+			WorkerID: fakeWorkerID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &worker.UpdateWorkerResponse{
+			ReleasedShifts: releasedShifts,
+			Worker:         fakeWorker,
+		}, resp)
+	})
+}
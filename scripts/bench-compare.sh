@@ -0,0 +1,23 @@
+#!/bin/sh
+# Runs the postgres benchmarks on the given git revision and on the working
+# tree, against the seeded database, and compares them with benchstat. The
+# benchmarks of the working tree are run on both, so revisions predating them
+# can be measured too.
+set -eu
+
+base=${1:?usage: bench-compare.sh <git revision>}
+count=${COUNT:-10}
+
+dir=$(mktemp -d)
+trap 'git worktree remove --force "$dir/base" >/dev/null 2>&1 || true; rm -rf "$dir"' EXIT
+
+git worktree add --detach "$dir/base" "$base" >/dev/null
+cp internal/postgres/benchmark_test.go "$dir/base/internal/postgres/"
+
+echo "running benchmarks on $base" >&2
+(cd "$dir/base" && go test -run '^$' -bench . -benchmem -count "$count" ./internal/postgres/) > "$dir/base.txt"
+
+echo "running benchmarks on the working tree" >&2
+go test -run '^$' -bench . -benchmem -count "$count" ./internal/postgres/ > "$dir/head.txt"
+
+go run golang.org/x/perf/cmd/benchstat@latest "$dir/base.txt" "$dir/head.txt"
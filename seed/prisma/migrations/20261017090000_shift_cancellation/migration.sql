@@ -0,0 +1,15 @@
+-- CreateTable
+CREATE TABLE "ShiftCancellation" (
+    "id" SERIAL NOT NULL,
+    "shift_id" INTEGER NOT NULL,
+    "worker_id" INTEGER NOT NULL,
+    "cancelled_at" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "ShiftCancellation_pkey" PRIMARY KEY ("id")
+);
+
+-- AddForeignKey
+ALTER TABLE "ShiftCancellation" ADD CONSTRAINT "ShiftCancellation_shift_id_fkey" FOREIGN KEY ("shift_id") REFERENCES "Shift"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "ShiftCancellation" ADD CONSTRAINT "ShiftCancellation_worker_id_fkey" FOREIGN KEY ("worker_id") REFERENCES "Worker"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
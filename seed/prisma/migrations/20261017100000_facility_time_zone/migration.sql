@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "Facility" ADD COLUMN     "time_zone" TEXT NOT NULL DEFAULT 'UTC';
@@ -0,0 +1,9 @@
+-- CreateEnum
+CREATE TYPE "DocumentStatus" AS ENUM ('PENDING', 'VERIFIED', 'REJECTED');
+
+-- AlterTable
+-- Documents held before verification was introduced are considered verified.
+ALTER TABLE "DocumentWorker" ADD COLUMN     "expires_at" TIMESTAMP(3),
+ADD COLUMN     "status" "DocumentStatus" NOT NULL DEFAULT 'VERIFIED';
+
+ALTER TABLE "DocumentWorker" ALTER COLUMN "status" SET DEFAULT 'PENDING';
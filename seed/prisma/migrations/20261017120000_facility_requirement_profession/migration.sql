@@ -0,0 +1,3 @@
+-- AlterTable
+-- Requirements without a profession apply to every profession.
+ALTER TABLE "FacilityRequirement" ADD COLUMN     "profession" "Profession";
@@ -0,0 +1,5 @@
+-- CreateEnum
+CREATE TYPE "ShiftCancellationActor" AS ENUM ('WORKER', 'FACILITY');
+
+-- AlterTable
+ALTER TABLE "ShiftCancellation" ADD COLUMN     "cancelled_by" "ShiftCancellationActor" NOT NULL DEFAULT 'WORKER';
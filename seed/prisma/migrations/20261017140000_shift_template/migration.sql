@@ -0,0 +1,28 @@
+-- AlterTable
+ALTER TABLE "Shift" ADD COLUMN     "template_id" INTEGER,
+ADD COLUMN     "template_occurrence" TIMESTAMP(3),
+ADD COLUMN     "template_slot" INTEGER;
+
+-- CreateTable
+CREATE TABLE "ShiftTemplate" (
+    "id" SERIAL NOT NULL,
+    "facility_id" INTEGER NOT NULL,
+    "profession" "Profession" NOT NULL,
+    "days_of_week" INTEGER[],
+    "start_time" TIME(0) NOT NULL,
+    "duration_minutes" INTEGER NOT NULL,
+    "headcount" INTEGER NOT NULL DEFAULT 1,
+    "count" INTEGER,
+    "starts_on" DATE,
+
+    CONSTRAINT "ShiftTemplate_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "Shift_template_id_template_occurrence_template_slot_key" ON "Shift"("template_id", "template_occurrence", "template_slot");
+
+-- AddForeignKey
+ALTER TABLE "Shift" ADD CONSTRAINT "Shift_template_id_fkey" FOREIGN KEY ("template_id") REFERENCES "ShiftTemplate"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "ShiftTemplate" ADD CONSTRAINT "ShiftTemplate_facility_id_fkey" FOREIGN KEY ("facility_id") REFERENCES "Facility"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+-- AlterEnum
+ALTER TYPE "ShiftCancellationActor" ADD VALUE 'PLATFORM';
@@ -0,0 +1,23 @@
+-- CreateEnum
+CREATE TYPE "FacilityStatusAction" AS ENUM ('ACTIVATE', 'DEACTIVATE', 'SUSPEND');
+
+-- AlterTable
+ALTER TABLE "Facility" ADD COLUMN     "suspended_until" TIMESTAMP(3);
+
+-- CreateTable
+CREATE TABLE "FacilityStatusChange" (
+    "id" SERIAL NOT NULL,
+    "facility_id" INTEGER NOT NULL,
+    "action" "FacilityStatusAction" NOT NULL,
+    "suspended_until" TIMESTAMP(3),
+    "cancelled_claims" BOOLEAN NOT NULL DEFAULT false,
+    "changed_at" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "FacilityStatusChange_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "FacilityStatusChange_facility_id_idx" ON "FacilityStatusChange"("facility_id");
+
+-- AddForeignKey
+ALTER TABLE "FacilityStatusChange" ADD CONSTRAINT "FacilityStatusChange_facility_id_fkey" FOREIGN KEY ("facility_id") REFERENCES "Facility"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
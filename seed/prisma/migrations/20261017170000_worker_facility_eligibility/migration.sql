@@ -0,0 +1,227 @@
+-- CreateTable
+CREATE TABLE "WorkerFacilityEligibility" (
+    "worker_id" INTEGER NOT NULL,
+    "facility_id" INTEGER NOT NULL,
+    "eligible_until" TIMESTAMP(3),
+
+    CONSTRAINT "WorkerFacilityEligibility_pkey" PRIMARY KEY ("worker_id","facility_id")
+);
+
+-- CreateIndex
+CREATE INDEX "WorkerFacilityEligibility_facility_id_idx" ON "WorkerFacilityEligibility"("facility_id");
+
+-- AddForeignKey
+ALTER TABLE "WorkerFacilityEligibility" ADD CONSTRAINT "WorkerFacilityEligibility_worker_id_fkey" FOREIGN KEY ("worker_id") REFERENCES "Worker"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkerFacilityEligibility" ADD CONSTRAINT "WorkerFacilityEligibility_facility_id_fkey" FOREIGN KEY ("facility_id") REFERENCES "Facility"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- A worker is listed for a facility when they hold every active document the
+-- facility requires for their profession, verified. The documents stop being
+-- valid at eligible_until, the earliest expiry among them, or never when null.
+-- Workers are listed for facilities requiring nothing from their profession
+-- too. NULL worker or facility IDs stand for all of them.
+CREATE FUNCTION worker_facility_eligibility(worker_ids INTEGER[], facility_ids INTEGER[])
+RETURNS TABLE ("worker_id" INTEGER, "facility_id" INTEGER, "eligible_until" TIMESTAMP(3))
+LANGUAGE sql STABLE AS $$
+    SELECT w.id, f.id, NULLIF(MIN(COALESCE(held.expires_at, 'infinity')), 'infinity')
+    FROM "Worker" w
+    CROSS JOIN "Facility" f
+    LEFT JOIN (
+        "FacilityRequirement" fr
+        INNER JOIN "Document" doc ON doc.id = fr.document_id AND doc.is_active = TRUE
+    ) ON fr.facility_id = f.id AND (fr.profession IS NULL OR fr.profession = w.profession)
+    -- When the required document stops being valid, if it is held at all.
+    LEFT JOIN LATERAL (
+        SELECT MAX(COALESCE(dw.expires_at, 'infinity')) AS expires_at
+        FROM "DocumentWorker" dw
+        WHERE dw.worker_id = w.id AND dw.document_id = fr.document_id AND dw.status = 'VERIFIED'
+    ) held ON TRUE
+    WHERE (worker_ids IS NULL OR w.id = ANY(worker_ids))
+    AND (facility_ids IS NULL OR f.id = ANY(facility_ids))
+    GROUP BY w.id, f.id
+    HAVING BOOL_AND(fr.document_id IS NULL OR held.expires_at IS NOT NULL)
+$$;
+
+-- Brings the eligibility of the given workers for the given facilities up to
+-- date. NULL worker or facility IDs stand for all of them.
+--
+-- Refreshes are serialized by an advisory lock held until the end of the
+-- transaction, whose first key 4 follows the ones taken by the service. Changes
+-- to requirements and to documents held are otherwise only serialized per
+-- facility and per worker, so two of them could each compute eligibility
+-- without seeing the other, leaving it stale once both commit. As the function
+-- is volatile, the eligibility is then computed from a snapshot taken once the
+-- lock is held, which under READ COMMITTED sees every refresh committed before.
+CREATE FUNCTION refresh_worker_facility_eligibility(worker_ids INTEGER[], facility_ids INTEGER[])
+RETURNS void
+LANGUAGE sql AS $$
+    SELECT pg_advisory_xact_lock(4, 0);
+
+    WITH computed AS (
+        SELECT * FROM worker_facility_eligibility(worker_ids, facility_ids)
+    ), upserted AS (
+        INSERT INTO "WorkerFacilityEligibility" ("worker_id", "facility_id", "eligible_until")
+        SELECT * FROM computed
+        ON CONFLICT ("worker_id", "facility_id") DO UPDATE SET "eligible_until" = EXCLUDED."eligible_until"
+    )
+    DELETE FROM "WorkerFacilityEligibility" wfe
+    WHERE (worker_ids IS NULL OR wfe.worker_id = ANY(worker_ids))
+    AND (facility_ids IS NULL OR wfe.facility_id = ANY(facility_ids))
+    AND NOT EXISTS (
+        SELECT 1 FROM computed c WHERE c.worker_id = wfe.worker_id AND c.facility_id = wfe.facility_id
+    );
+$$;
+
+-- The triggers below run once per statement, refreshing the eligibility of
+-- the workers or facilities it touched. Transition tables can't be shared by
+-- triggers of different events, so each event has its own trigger.
+
+CREATE FUNCTION document_worker_refresh_eligibility() RETURNS trigger
+LANGUAGE plpgsql AS $$
+DECLARE
+    worker_ids INTEGER[];
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        SELECT ARRAY_AGG(DISTINCT worker_id) INTO worker_ids FROM new_rows;
+    ELSIF TG_OP = 'DELETE' THEN
+        SELECT ARRAY_AGG(DISTINCT worker_id) INTO worker_ids FROM old_rows;
+    ELSE
+        SELECT ARRAY_AGG(worker_id) INTO worker_ids FROM (
+            SELECT worker_id FROM new_rows UNION SELECT worker_id FROM old_rows
+        ) changed;
+    END IF;
+
+    IF worker_ids IS NOT NULL THEN
+        PERFORM refresh_worker_facility_eligibility(worker_ids, NULL);
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER document_worker_inserted_refresh_eligibility
+AFTER INSERT ON "DocumentWorker" REFERENCING NEW TABLE AS new_rows
+FOR EACH STATEMENT EXECUTE FUNCTION document_worker_refresh_eligibility();
+
+CREATE TRIGGER document_worker_updated_refresh_eligibility
+AFTER UPDATE ON "DocumentWorker" REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+FOR EACH STATEMENT EXECUTE FUNCTION document_worker_refresh_eligibility();
+
+CREATE TRIGGER document_worker_deleted_refresh_eligibility
+AFTER DELETE ON "DocumentWorker" REFERENCING OLD TABLE AS old_rows
+FOR EACH STATEMENT EXECUTE FUNCTION document_worker_refresh_eligibility();
+
+CREATE FUNCTION facility_requirement_refresh_eligibility() RETURNS trigger
+LANGUAGE plpgsql AS $$
+DECLARE
+    facility_ids INTEGER[];
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        SELECT ARRAY_AGG(DISTINCT facility_id) INTO facility_ids FROM new_rows;
+    ELSIF TG_OP = 'DELETE' THEN
+        SELECT ARRAY_AGG(DISTINCT facility_id) INTO facility_ids FROM old_rows;
+    ELSE
+        SELECT ARRAY_AGG(facility_id) INTO facility_ids FROM (
+            SELECT facility_id FROM new_rows UNION SELECT facility_id FROM old_rows
+        ) changed;
+    END IF;
+
+    IF facility_ids IS NOT NULL THEN
+        PERFORM refresh_worker_facility_eligibility(NULL, facility_ids);
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER facility_requirement_inserted_refresh_eligibility
+AFTER INSERT ON "FacilityRequirement" REFERENCING NEW TABLE AS new_rows
+FOR EACH STATEMENT EXECUTE FUNCTION facility_requirement_refresh_eligibility();
+
+CREATE TRIGGER facility_requirement_updated_refresh_eligibility
+AFTER UPDATE ON "FacilityRequirement" REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+FOR EACH STATEMENT EXECUTE FUNCTION facility_requirement_refresh_eligibility();
+
+CREATE TRIGGER facility_requirement_deleted_refresh_eligibility
+AFTER DELETE ON "FacilityRequirement" REFERENCING OLD TABLE AS old_rows
+FOR EACH STATEMENT EXECUTE FUNCTION facility_requirement_refresh_eligibility();
+
+-- Workers are refreshed when added or when changing profession.
+CREATE FUNCTION worker_refresh_eligibility() RETURNS trigger
+LANGUAGE plpgsql AS $$
+DECLARE
+    worker_ids INTEGER[];
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        SELECT ARRAY_AGG(id) INTO worker_ids FROM new_rows;
+    ELSE
+        SELECT ARRAY_AGG(n.id) INTO worker_ids
+        FROM new_rows n
+        INNER JOIN old_rows o ON o.id = n.id
+        WHERE n.profession IS DISTINCT FROM o.profession;
+    END IF;
+
+    IF worker_ids IS NOT NULL THEN
+        PERFORM refresh_worker_facility_eligibility(worker_ids, NULL);
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER worker_inserted_refresh_eligibility
+AFTER INSERT ON "Worker" REFERENCING NEW TABLE AS new_rows
+FOR EACH STATEMENT EXECUTE FUNCTION worker_refresh_eligibility();
+
+CREATE TRIGGER worker_updated_refresh_eligibility
+AFTER UPDATE ON "Worker" REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+FOR EACH STATEMENT EXECUTE FUNCTION worker_refresh_eligibility();
+
+-- Facilities are refreshed when added.
+CREATE FUNCTION facility_refresh_eligibility() RETURNS trigger
+LANGUAGE plpgsql AS $$
+DECLARE
+    facility_ids INTEGER[];
+BEGIN
+    SELECT ARRAY_AGG(id) INTO facility_ids FROM new_rows;
+
+    IF facility_ids IS NOT NULL THEN
+        PERFORM refresh_worker_facility_eligibility(NULL, facility_ids);
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER facility_inserted_refresh_eligibility
+AFTER INSERT ON "Facility" REFERENCING NEW TABLE AS new_rows
+FOR EACH STATEMENT EXECUTE FUNCTION facility_refresh_eligibility();
+
+-- Documents are refreshed when activated or retired, for the facilities
+-- requiring them.
+CREATE FUNCTION document_refresh_eligibility() RETURNS trigger
+LANGUAGE plpgsql AS $$
+DECLARE
+    facility_ids INTEGER[];
+BEGIN
+    SELECT ARRAY_AGG(DISTINCT fr.facility_id) INTO facility_ids
+    FROM new_rows n
+    INNER JOIN old_rows o ON o.id = n.id
+    INNER JOIN "FacilityRequirement" fr ON fr.document_id = n.id
+    WHERE n.is_active IS DISTINCT FROM o.is_active;
+
+    IF facility_ids IS NOT NULL THEN
+        PERFORM refresh_worker_facility_eligibility(NULL, facility_ids);
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER document_updated_refresh_eligibility
+AFTER UPDATE ON "Document" REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+FOR EACH STATEMENT EXECUTE FUNCTION document_refresh_eligibility();
+
+-- Backfill
+SELECT refresh_worker_facility_eligibility(NULL, NULL);
@@ -1,4 +1,4 @@
-import { PrismaClient, Profession } from '@prisma/client';
+import { DocumentStatus, PrismaClient, Profession } from '@prisma/client';
 import { randomBytes } from 'node:crypto';
 const prisma = new PrismaClient();
 
@@ -9,6 +9,12 @@ const maxWorkers = 100;
 const maxShifts = 20 * 1000 * 100; // 2M
 const startHours = [5, 13, 20];
 const shiftHours = 5;
+const timeZones = [
+  'America/New_York',
+  'America/Chicago',
+  'America/Denver',
+  'America/Los_Angeles',
+];
 
 function returnRandomNumberBetweenOneAndLimit(limit: number): number {
   return Math.floor(Math.random() * limit) + 1;
@@ -28,6 +34,21 @@ function returnRandomProfession(): Profession {
   return [Profession.CNA, Profession.LVN, Profession.RN][index];
 }
 
+// Most requirements apply to every profession.
+function returnRandomRequirementProfession(): Profession | null {
+  return returnRandomNumberBetweenOneAndLimit(4) === 1 ? returnRandomProfession() : null;
+}
+
+function returnRandomTimeZone(): string {
+  const index = returnRandomNumberBetweenOneAndLimit(timeZones.length) - 1;
+  return timeZones[index];
+}
+
+function returnRandomDocumentStatus(): DocumentStatus {
+  const index = returnRandomNumberBetweenOneAndLimit(3) - 1;
+  return [DocumentStatus.PENDING, DocumentStatus.VERIFIED, DocumentStatus.REJECTED][index];
+}
+
 function returnRandomWorkerIdForshift(): number | null {
   const returnWorkerId = returnRandomBoolean();
   return returnWorkerId
@@ -45,9 +66,9 @@ async function createDeterministicWorker() {
   const worker = await prisma.worker.create({data: {name: "Deterministic #1", profession: Profession.CNA, is_active: true}})
   const worker2 = await prisma.worker.create({data: {name: "Deterministic #2", profession: Profession.LVN, is_active: true}})
   const worker3 = await prisma.worker.create({data: {name: "Deterministic #3", profession: Profession.RN, is_active: true}})
-  const workerDocuments = Array.from({length: maxDocuments}, (_, i) => i + 1).map((number) => ({worker_id: worker.id, document_id: number}))
-  const workerDocuments2 = Array.from({length: maxDocuments}, (_, i) => i + 1).map((number) => ({worker_id: worker2.id, document_id: number}))
-  const workerDocuments3 = Array.from({length: maxDocuments}, (_, i) => i + 1).map((number) => ({worker_id: worker3.id, document_id: number}))
+  const workerDocuments = Array.from({length: maxDocuments}, (_, i) => i + 1).map((number) => ({worker_id: worker.id, document_id: number, status: DocumentStatus.VERIFIED}))
+  const workerDocuments2 = Array.from({length: maxDocuments}, (_, i) => i + 1).map((number) => ({worker_id: worker2.id, document_id: number, status: DocumentStatus.VERIFIED}))
+  const workerDocuments3 = Array.from({length: maxDocuments}, (_, i) => i + 1).map((number) => ({worker_id: worker3.id, document_id: number, status: DocumentStatus.VERIFIED}))
   await prisma.documentWorker.createMany({data: workerDocuments})
   await prisma.documentWorker.createMany({data: workerDocuments2})
   await prisma.documentWorker.createMany({data: workerDocuments3})
@@ -93,6 +114,7 @@ async function insertFacilities() {
     facilities.push({
       name: returnRandomString(20),
       is_active: returnRandomBoolean(),
+      time_zone: returnRandomTimeZone(),
     });
   }
   await prisma.facility.createMany({ data: facilities });
@@ -121,6 +143,7 @@ async function insertFacilityRequirements() {
     facilityRequirements.push({
       facility_id: returnRandomNumberBetweenOneAndLimit(maxFacilities),
       document_id: returnRandomNumberBetweenOneAndLimit(maxDocuments),
+      profession: returnRandomRequirementProfession(),
     });
   }
   await prisma.facilityRequirement.createMany({ data: facilityRequirements });
@@ -134,6 +157,7 @@ async function insertDocumentWorkers() {
     documentWorkers.push({
       worker_id: returnRandomNumberBetweenOneAndLimit(maxWorkers),
       document_id: returnRandomNumberBetweenOneAndLimit(maxDocuments),
+      status: returnRandomDocumentStatus(),
     });
   }
   await prisma.documentWorker.createMany({ data: documentWorkers });